@@ -0,0 +1,7 @@
+data "awx_credential_types" "cloud" {
+  kind = "cloud"
+}
+
+output "aws_credential_type_id" {
+  value = one([for t in data.awx_credential_types.cloud.credential_types : t.id if t.namespace == "aws"])
+}
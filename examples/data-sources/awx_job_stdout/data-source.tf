@@ -0,0 +1,16 @@
+data "awx_job_template" "baseconfig" {
+  name = "baseconfig"
+}
+
+resource "awx_job_template_launch" "baseconfig" {
+  job_template_id     = data.awx_job_template.baseconfig.id
+  wait_for_completion = true
+}
+
+data "awx_job_stdout" "baseconfig" {
+  job_id = awx_job_template_launch.baseconfig.id
+}
+
+output "baseconfig_recap" {
+  value = regex("PLAY RECAP[\\s\\S]*", data.awx_job_stdout.baseconfig.content)
+}
@@ -0,0 +1,2 @@
+# Ad hoc command can be imported by specifying the numeric identifier.
+terraform import awx_ad_hoc_command.example 950
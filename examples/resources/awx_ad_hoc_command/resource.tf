@@ -0,0 +1,42 @@
+variable "provisioned_hosts" {
+  type = list(string)
+}
+
+data "awx_organization" "example" {
+  name = "Default"
+}
+
+data "awx_inventory" "example" {
+  name            = "private_services"
+  organization_id = data.awx_organization.example.id
+}
+
+resource "awx_credential_machine" "provisioning" {
+  name            = "provisioning"
+  organization_id = data.awx_organization.example.id
+  username        = "ansible"
+  ssh_key_data    = file("~/.ssh/provisioning")
+}
+
+# Checks that newly provisioned hosts are reachable, through the RBAC and audit trail of AWX.
+resource "awx_ad_hoc_command" "ping" {
+  inventory_id  = data.awx_inventory.example.id
+  module_name   = "ping"
+  limit         = "edge-routers"
+  credential_id = awx_credential_machine.provisioning.id
+
+  wait_for_completion = true
+  cancel_on_timeout   = true
+
+  triggers = {
+    hosts = join(",", var.provisioned_hosts)
+  }
+
+  timeouts {
+    create = "10m"
+  }
+}
+
+data "awx_job_stdout" "ping" {
+  ad_hoc_command_id = awx_ad_hoc_command.ping.id
+}
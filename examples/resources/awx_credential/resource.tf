@@ -7,8 +7,10 @@ resource "awx_credential" "example" {
   description        = "Example of ansible vault credential"
   organization_id    = awx_organization.example.id
   credential_type_id = 3 # ansible vault
-  inputs = {
+  inputs = jsonencode({
+    vault_id = "password"
+  })
+  sensitive_inputs = jsonencode({
     vault_password = "admin"
-    vault_id       = "password"
-  }
+  })
 }
@@ -0,0 +1,5 @@
+# Order can be imported by specifying the numeric identifier.
+terraform import awx_credential_amazon_web_services.example 541
+
+# It can also be imported by its natural key, <organization>/<name>.
+terraform import awx_credential_amazon_web_services.example "Default/example"
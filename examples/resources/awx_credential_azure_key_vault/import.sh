@@ -1,2 +1,5 @@
 # Order can be imported by specifying the numeric identifier.
 terraform import awx_credential_azure_key_vault.example 510
+
+# It can also be imported by its natural key, <organization>/<name>.
+terraform import awx_credential_azure_key_vault.example "Default/example"
@@ -0,0 +1,13 @@
+resource "awx_organization" "example" {
+  name = "example"
+}
+
+resource "awx_credential_azure_resource_manager" "example" {
+  name            = "awx-azure-rm-credential"
+  organization_id = awx_organization.example.id
+  description     = "This is an Azure Resource Manager credential"
+  subscription    = "00000000-0000-0000-0000-000000000000"
+  client          = "11111111-1111-1111-1111-111111111111"
+  secret          = var.azure_client_secret
+  tenant          = "22222222-2222-2222-2222-222222222222"
+}
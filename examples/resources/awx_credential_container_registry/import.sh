@@ -0,0 +1,5 @@
+# Order can be imported by specifying the numeric identifier.
+terraform import awx_credential_container_registry.example 515
+
+# It can also be imported by its natural key, <organization>/<name>.
+terraform import awx_credential_container_registry.example "Default/example"
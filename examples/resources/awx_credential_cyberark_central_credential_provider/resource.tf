@@ -0,0 +1,13 @@
+resource "awx_organization" "example" {
+  name = "example"
+}
+
+resource "awx_credential_cyberark_central_credential_provider" "example" {
+  name            = "awx-cyberark-credential"
+  organization_id = awx_organization.example.id
+  description     = "This is a CyberArk Central Credential Provider credential"
+  url             = "https://cyberark.example.com"
+  app_id          = "awx"
+  client_key      = var.cyberark_client_key
+  client_cert     = var.cyberark_client_cert
+}
@@ -1,2 +1,5 @@
 # Order can be imported by specifying the numeric identifier.
 terraform import awx_credential_google_compute_engine.example 540
+
+# It can also be imported by its natural key, <organization>/<name>.
+terraform import awx_credential_google_compute_engine.example "Default/example"
@@ -0,0 +1,19 @@
+resource "awx_organization" "example" {
+  name = "example"
+}
+
+resource "awx_credential_hashicorp_vault_secret_lookup" "example" {
+  name            = "awx-vault-lookup-credential"
+  organization_id = awx_organization.example.id
+  description     = "This is a HashiCorp Vault Secret Lookup credential"
+  url             = "https://vault.example.com:8200"
+  role_id         = var.vault_role_id
+  secret_id       = var.vault_secret_id
+  api_version     = "v2"
+
+  validate_on_create = true
+  validation_metadata = {
+    secret_path = "/kv/ansible"
+    secret_key  = "ssh_key"
+  }
+}
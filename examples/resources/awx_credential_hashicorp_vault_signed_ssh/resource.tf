@@ -0,0 +1,11 @@
+resource "awx_organization" "example" {
+  name = "example"
+}
+
+resource "awx_credential_hashicorp_vault_signed_ssh" "example" {
+  name            = "awx-vault-ssh-credential"
+  organization_id = awx_organization.example.id
+  description     = "This is a HashiCorp Vault Signed SSH credential"
+  url             = "https://vault.example.com:8200"
+  token           = var.vault_token
+}
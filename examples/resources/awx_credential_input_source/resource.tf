@@ -1,8 +1,25 @@
+resource "awx_credential_hashicorp_vault_secret_lookup" "vault" {
+  name            = "vault"
+  organization_id = 1
+  url             = "https://vault.example.com:8200"
+  token           = var.vault_token
+  api_version     = "v2"
+}
+
+resource "awx_credential_machine" "example" {
+  name            = "example"
+  organization_id = 1
+  username        = "ansible"
+}
+
 resource "awx_credential_input_source" "example" {
-  description      = "example"
-  input_field_name = "ssh_key_data"
-  target           = 10
-  source           = 10
+  description        = "example"
+  input_field_name   = "ssh_key_data"
+  target             = awx_credential_machine.example.id
+  source             = awx_credential_hashicorp_vault_secret_lookup.vault.id
+  validate_on_create = true
   metadata = {
+    secret_path = "/kv/ansible"
+    secret_key  = "ssh_key"
   }
 }
@@ -0,0 +1,18 @@
+resource "awx_organization" "example" {
+  name = "example"
+}
+
+resource "awx_credential_kubernetes_bearer_token" "example" {
+  name            = "awx-kubernetes-credential"
+  organization_id = awx_organization.example.id
+  description     = "This is a Kubernetes bearer token credential"
+  host            = "https://kubernetes.example.com:6443"
+  bearer_token    = var.kubernetes_token
+  ssl_ca_cert     = var.kubernetes_ca_certificate
+}
+
+resource "awx_instance_group" "example" {
+  name               = "kubernetes"
+  is_container_group = true
+  credential_id      = awx_credential_kubernetes_bearer_token.example.id
+}
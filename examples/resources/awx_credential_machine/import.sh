@@ -1,3 +1,6 @@
 # Order can be imported by specifying the numeric identifier.
 terraform import awx_credential_machine.example_1 560
 terraform import awx_credential_machine.example_2 561
+
+# It can also be imported by its natural key, <organization>/<name>.
+terraform import awx_credential_machine.example_1 "Default/example"
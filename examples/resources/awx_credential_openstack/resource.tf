@@ -0,0 +1,14 @@
+resource "awx_organization" "example" {
+  name = "example"
+}
+
+resource "awx_credential_openstack" "example" {
+  name            = "awx-openstack-credential"
+  organization_id = awx_organization.example.id
+  description     = "This is an OpenStack credential"
+  host            = "https://keystone.example.com:5000/v3"
+  username        = "admin"
+  password        = var.openstack_password
+  project         = "example"
+  domain          = "Default"
+}
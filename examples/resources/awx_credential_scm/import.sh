@@ -1,2 +1,5 @@
 # Order can be imported by specifying the numeric identifier.
 terraform import awx_credential_scm.example 570
+
+# It can also be imported by its natural key, <organization>/<name>.
+terraform import awx_credential_scm.example "Default/example"
@@ -0,0 +1,12 @@
+resource "awx_organization" "example" {
+  name = "example"
+}
+
+resource "awx_credential_thycotic_secret_server" "example" {
+  name            = "awx-thycotic-credential"
+  organization_id = awx_organization.example.id
+  description     = "This is a Thycotic Secret Server credential"
+  server_url      = "https://example.secretservercloud.com/SecretServer"
+  username        = "awx"
+  password        = var.thycotic_password
+}
@@ -0,0 +1,5 @@
+# Order can be imported by specifying the numeric identifier.
+terraform import awx_credential_vmware_vcenter.example 543
+
+# It can also be imported by its natural key, <organization>/<name>.
+terraform import awx_credential_vmware_vcenter.example "Default/example"
@@ -0,0 +1,12 @@
+resource "awx_organization" "example" {
+  name = "example"
+}
+
+resource "awx_credential_vmware_vcenter" "example" {
+  name            = "awx-vcenter-credential"
+  organization_id = awx_organization.example.id
+  description     = "This is a VMware vCenter credential"
+  host            = "vcenter.example.com"
+  username        = "administrator@vsphere.local"
+  password        = var.vcenter_password
+}
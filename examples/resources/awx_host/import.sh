@@ -1,2 +1,5 @@
 # Order can be imported by specifying the numeric identifier.
 terraform import awx_host.example 600
+
+# It can also be imported by its natural key, <organization>/<inventory>/<name>.
+terraform import awx_host.example "Default/Example Inventory/host.example.com"
@@ -13,3 +13,13 @@ resource "awx_host" "example" {
 ansible_host: 192.168.178.29
 YAML
 }
+
+resource "awx_host" "map" {
+  name         = "some-host-node-2"
+  inventory_id = data.awx_inventory.default.id
+  variables_map = merge(local.common_host_vars, {
+    ansible_host = "192.168.178.30"
+    ansible_port = 2222
+    roles        = jsonencode(["web", "db"])
+  })
+}
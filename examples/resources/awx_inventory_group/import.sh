@@ -1,2 +1,5 @@
 # Order can be imported by specifying the numeric identifier.
 terraform import awx_inventory_group.example 630
+
+# It can also be imported by its natural key, <organization>/<inventory>/<name>.
+terraform import awx_inventory_group.example "Default/Example Inventory/webservers"
@@ -0,0 +1,2 @@
+# Association can be imported by specifying the inventory and instance group numeric identifiers.
+terraform import awx_inventory_instance_groups.example 5:2
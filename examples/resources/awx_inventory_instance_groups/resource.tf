@@ -0,0 +1,12 @@
+resource "awx_instance_group" "example" {
+  name = "example"
+}
+
+data "awx_inventory" "example" {
+  name = "Example Inventory"
+}
+
+resource "awx_inventory_instance_groups" "example" {
+  inventory_id      = data.awx_inventory.example.id
+  instance_group_id = awx_instance_group.example.id
+}
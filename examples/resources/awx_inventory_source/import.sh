@@ -1,2 +1,5 @@
 # Order can be imported by specifying the numeric identifier.
 terraform import awx_inventory_source.example 640
+
+# It can also be imported by its natural key, <organization>/<inventory>/<name>.
+terraform import awx_inventory_source.example "Default/Example Inventory/example-source"
@@ -1,2 +1,5 @@
 # Order can be imported by specifying the numeric identifier.
 terraform import awx_job_template.example 650
+
+# It can also be imported by its natural key, <organization>/<name>.
+terraform import awx_job_template.example "Default/baseconfig"
@@ -1,2 +1,2 @@
-# Order can be imported by specifying the numeric identifier.
-terraform import awx_job_template_credential.example 660
+# Association can be imported by specifying the job template and credential numeric identifiers.
+terraform import awx_job_template_credential.example 10:660
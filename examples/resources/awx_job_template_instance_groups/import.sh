@@ -0,0 +1,2 @@
+# Association can be imported by specifying the job template and instance group numeric identifiers.
+terraform import awx_job_template_instance_groups.example 10:2
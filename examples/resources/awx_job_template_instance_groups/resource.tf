@@ -0,0 +1,12 @@
+resource "awx_instance_group" "example" {
+  name = "example"
+}
+
+data "awx_job_template" "example" {
+  name = "baseconfig"
+}
+
+resource "awx_job_template_instance_groups" "example" {
+  job_template_id   = data.awx_job_template.example.id
+  instance_group_id = awx_instance_group.example.id
+}
@@ -16,14 +16,49 @@ data "awx_inventory" "example" {
 }
 
 resource "awx_job_template" "baseconfig" {
-  name           = "baseconfig"
-  job_type       = "run"
-  inventory_id   = data.awx_inventory.example.id
-  project_id     = awx_project.example.id
-  playbook       = "master-configure-system.yml"
-  become_enabled = true
+  name                    = "baseconfig"
+  job_type                = "run"
+  inventory_id            = data.awx_inventory.example.id
+  project_id              = awx_project.example.id
+  playbook                = "master-configure-system.yml"
+  become_enabled          = true
+  ask_limit_on_launch     = true
+  ask_tags_on_launch      = true
+  ask_verbosity_on_launch = true
+  ask_diff_mode_on_launch = true
 }
 
+# Prompts the job template does not ask for on launch are rejected at plan time.
 resource "awx_job_template_launch" "now" {
   job_template_id = awx_job_template.baseconfig.id
+  limit           = "edge-routers"
+  job_tags        = "ntp,dns"
+  verbosity       = 0
+  diff_mode       = true
+
+  wait_for_completion = true
+  cancel_on_timeout   = true
+  cancel_on_destroy   = true
+
+  # Retries unreachable network devices without running again on the healthy ones.
+  relaunch_failed_hosts = 2
+
+  timeouts {
+    create = "30m"
+  }
+}
+
+# Values published by the playbook with set_stats.
+output "release" {
+  value = jsondecode(awx_job_template_launch.now.artifacts)["release"]
+}
+
+# Runs the job again in place, keeping the history in job_ids, whenever the branch changes.
+resource "awx_job_template_launch" "on_branch_change" {
+  job_template_id  = awx_job_template.baseconfig.id
+  launch_on_update = true
+
+  triggers = {
+    scm_branch = awx_project.example.scm_branch
+  }
 }
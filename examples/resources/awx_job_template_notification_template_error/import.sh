@@ -1,2 +1,2 @@
-# Order can be imported by specifying the numeric identifier.
-terraform import awx_job_template_notification_template_error.example 680
+# Association can be imported by specifying the job template and notification template numeric identifiers.
+terraform import awx_job_template_notification_template_error.example 10:680
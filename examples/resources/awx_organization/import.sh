@@ -1,2 +1,5 @@
 # Order can be imported by specifying the numeric identifier.
 terraform import awx_organization.example 720
+
+# It can also be imported by its natural key, the organization name.
+terraform import awx_organization.example "Default"
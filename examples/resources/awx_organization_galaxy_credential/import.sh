@@ -0,0 +1,2 @@
+# Association can be imported by specifying the organization and galaxy credential numeric identifiers.
+terraform import awx_organization_galaxy_credential.example 1:515
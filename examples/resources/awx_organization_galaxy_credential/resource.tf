@@ -0,0 +1,15 @@
+resource "awx_organization" "example" {
+  name = "example"
+}
+
+resource "awx_credential_galaxy" "example" {
+  name            = "example"
+  organization_id = awx_organization.example.id
+  url             = "https://galaxy.ansible.com"
+  token           = "example"
+}
+
+resource "awx_organization_galaxy_credential" "example" {
+  organization_id = awx_organization.example.id
+  credential_id   = awx_credential_galaxy.example.id
+}
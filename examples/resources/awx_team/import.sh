@@ -1,2 +1,5 @@
 # Order can be imported by specifying the numeric identifier.
 terraform import awx_team.example 780
+
+# It can also be imported by its natural key, <organization>/<name>.
+terraform import awx_team.example "Default/admins"
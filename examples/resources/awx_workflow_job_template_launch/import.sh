@@ -0,0 +1,2 @@
+# Workflow job template launch can be imported by specifying the numeric identifier of the workflow job.
+terraform import awx_workflow_job_template_launch.example 880
@@ -0,0 +1,31 @@
+data "awx_organization" "example" {
+  name = "Default"
+}
+
+resource "awx_workflow_job_template" "release" {
+  name                    = "release"
+  organization_id         = data.awx_organization.example.id
+  ask_limit_on_launch     = true
+  ask_variables_on_launch = true
+}
+
+# Prompts the workflow job template does not ask for on launch are rejected at plan time.
+resource "awx_workflow_job_template_launch" "now" {
+  workflow_job_template_id = awx_workflow_job_template.release.id
+  limit                    = "edge-routers"
+  extra_vars               = <<YAML
+release: 1.4.2
+YAML
+
+  wait_for_completion = true
+  cancel_on_timeout   = true
+
+  timeouts {
+    create = "1h"
+  }
+}
+
+# The jobs spawned by each node of the workflow.
+output "release_jobs" {
+  value = { for n in awx_workflow_job_template_launch.now.nodes : n.identifier => n.job_id }
+}
@@ -1,2 +1,3 @@
-# Order can be imported by specifying the numeric identifier.
-terraform import awx_workflow_job_template_node_success.example 840
+# Node can be imported by specifying the parent workflow job template node and node numeric identifiers.
+# Unlike association resources, the imported ID is the node ID alone, as other nodes use it as their parent.
+terraform import awx_workflow_job_template_node_success.example 810:840
@@ -1,2 +1,2 @@
-# Order can be imported by specifying the numeric identifier.
-terraform import awx_workflow_job_template_notification_template_error.example 850
+# Association can be imported by specifying the workflow job template and notification template numeric identifiers.
+terraform import awx_workflow_job_template_notification_template_error.example 20:850
@@ -1,2 +1,2 @@
-# Order can be imported by specifying the numeric identifier.
-terraform import awx_workflow_job_template_notification_template_success.example 870
+# Association can be imported by specifying the workflow job template and notification template numeric identifiers.
+terraform import awx_workflow_job_template_notification_template_success.example 20:870
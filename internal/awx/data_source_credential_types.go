@@ -0,0 +1,177 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
+)
+
+//nolint:funlen
+func dataSourceCredentialTypes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCredentialTypesRead,
+		Description: "Use this data source to list the credential types of AWX, managed and custom, with their input schemas.",
+		Schema: map[string]*schema.Schema{
+			"kind": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the credential types of this kind, such as `cloud` or `external`.",
+			},
+			"credential_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the credential type",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the credential type",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the credential type",
+						},
+						"kind": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The kind of the credential type",
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The namespace of a managed credential type, which is the same on every AWX install",
+						},
+						"managed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the credential type is managed by AWX",
+						},
+						"inputs": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The inputs schema of the credential type, as JSON",
+						},
+						"fields": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The input fields of the credential type",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The input name, as used in the credential inputs",
+									},
+									"label": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The label of the input",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The type of the input, `string` or `boolean`",
+									},
+									"secret": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether AWX encrypts the input",
+									},
+									"multiline": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the input spans several lines",
+									},
+									"required": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the input is required",
+									},
+									"choices": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "The values allowed for the input, any when empty",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCredentialTypesRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	params := map[string]string{}
+	if kind, ok := d.GetOk("kind"); ok {
+		params["kind"] = kind.(string)
+	}
+
+	credTypes, err := client.CredentialTypeService.ListCredentialTypes(params)
+	if err != nil {
+		return utils.DiagFetch("Credential Types", params, err)
+	}
+
+	parsed := make([]map[string]interface{}, 0, len(credTypes))
+	for _, ct := range credTypes {
+		inputs, err := credentialTypeInputs(ct)
+		if err != nil {
+			return utils.DiagFetch("Credential Types", ct.ID, err)
+		}
+		rawInputs, err := json.Marshal(ct.Inputs)
+		if err != nil {
+			return utils.DiagFetch("Credential Types", ct.ID, err)
+		}
+
+		required := make(map[string]bool, len(inputs.Required))
+		for _, k := range inputs.Required {
+			required[k] = true
+		}
+		fields := make([]map[string]interface{}, 0, len(inputs.Fields))
+		for _, f := range inputs.Fields {
+			fields = append(fields, map[string]interface{}{
+				"id":        f.ID,
+				"label":     f.Label,
+				"type":      f.Type,
+				"secret":    f.Secret,
+				"multiline": f.Multiline,
+				"required":  required[f.ID],
+				"choices":   f.Choices,
+			})
+		}
+
+		parsed = append(parsed, map[string]interface{}{
+			"id":          ct.ID,
+			"name":        ct.Name,
+			"description": ct.Description,
+			"kind":        ct.Kind,
+			"namespace":   ct.Namespace,
+			"managed":     ct.Managed || ct.ManagedByTower,
+			"inputs":      string(rawInputs),
+			"fields":      fields,
+		})
+	}
+
+	if err := d.Set("credential_types", parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package awx
+
+import (
+	"context"
+	"io"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
+)
+
+func dataSourceJobStdout() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJobStdoutRead,
+		Description: "Use this data source to read the plain text output of a job, a project update or an ad hoc " +
+			"command, such as the playbook output of an `awx_job_template_launch`.",
+		Schema: map[string]*schema.Schema{
+			"job_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"job_id", "project_update_id", "ad_hoc_command_id"},
+				Description:  "The ID of the job to read the output of.",
+			},
+			"project_update_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"job_id", "project_update_id", "ad_hoc_command_id"},
+				Description:  "The ID of the project update to read the output of.",
+			},
+			"ad_hoc_command_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"job_id", "project_update_id", "ad_hoc_command_id"},
+				Description:  "The ID of the ad hoc command to read the output of.",
+			},
+			"start_line": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The first line of the output to read, counting from 0.",
+			},
+			"end_line": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The line after the last one to read, up to the end of the output when unset.",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The output, without ANSI colors. It is partial while the job is running.",
+			},
+		},
+	}
+}
+
+func dataSourceJobStdoutRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	opts := &awx.StdoutOptions{
+		// The download format is never replaced by a notice when the output is too large to display.
+		Format:    awx.StdoutFormatTxtDownload,
+		StartLine: d.Get("start_line").(int),
+		EndLine:   d.Get("end_line").(int),
+	}
+
+	kind, id := "Job Stdout", d.Get("job_id").(int)
+	stdout := client.JobService.Stdout
+	if v, ok := d.GetOk("project_update_id"); ok {
+		kind, id = "Project Update Stdout", v.(int)
+		stdout = client.ProjectUpdatesService.ProjectUpdateStdout
+	}
+	if v, ok := d.GetOk("ad_hoc_command_id"); ok {
+		kind, id = "Ad Hoc Command Stdout", v.(int)
+		stdout = client.AdHocCommandService.Stdout
+	}
+
+	r, err := stdout(id, opts)
+	if err != nil {
+		return utils.DiagFetch(kind, id, err)
+	}
+	defer r.Close()
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return utils.DiagFetch(kind, id, err)
+	}
+
+	if err := d.Set("content", string(content)); err != nil {
+		return utils.DiagSet("content", id, err)
+	}
+	d.SetId(strconv.Itoa(id))
+	return nil
+}
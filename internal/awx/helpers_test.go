@@ -0,0 +1,48 @@
+package awx
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testResourceDataConfig returns the planned data of a new r configured with attrs, with
+// the raw configuration which schema.TestResourceDataRaw leaves null.
+func testResourceDataConfig(t *testing.T, r *schema.Resource, attrs map[string]cty.Value) *schema.ResourceData {
+	t.Helper()
+	coreSchema := r.CoreConfigSchema()
+	config, err := coreSchema.CoerceValue(cty.ObjectVal(attrs))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sm := schema.InternalMap(r.SchemaMap())
+	diff, err := sm.Diff(context.Background(), nil, terraform.NewResourceConfigShimmed(config, coreSchema), nil, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil {
+		diff = &terraform.InstanceDiff{}
+	}
+	diff.RawConfig = config
+	d, err := sm.Data(nil, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+// checkErrors fails the test unless err is made of exactly the errors want.
+func checkErrors(t *testing.T, err error, want []string) {
+	t.Helper()
+	var got []string
+	if err != nil {
+		got = strings.Split(err.Error(), "\n")
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("Expecting the errors %q but got %q", want, got)
+	}
+}
@@ -51,6 +51,15 @@ func Provider() *schema.Provider { //nolint:funlen
 				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("AWX_TOKEN", ""),
 			},
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWX_ADOPT_EXISTING", false),
+				Description: "Take over existing objects with the same name instead of failing to create them. Only " +
+					"`awx_credential`, `awx_host`, `awx_inventory`, `awx_inventory_group`, `awx_inventory_source`, " +
+					"`awx_job_template`, `awx_organization`, `awx_project`, `awx_team` and `awx_workflow_job_template` " +
+					"support it, they can override it with their own `adopt_existing` argument",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"awx_credential_azure_key_vault":                          resourceCredentialAzureKeyVault(),
@@ -64,6 +73,15 @@ func Provider() *schema.Provider { //nolint:funlen
 			"awx_credential_gitlab":                                   resourceCredentialGitlab(),
 			"awx_credential_galaxy":                                   resourceCredentialGalaxy(),
 			"awx_credential_vault":                                    resourceCredentialVault(),
+			"awx_credential_amazon_web_services":                      resourceCredentialAmazonWebServices(),
+			"awx_credential_azure_resource_manager":                   resourceCredentialAzureResourceManager(),
+			"awx_credential_vmware_vcenter":                           resourceCredentialVMwareVCenter(),
+			"awx_credential_openstack":                                resourceCredentialOpenStack(),
+			"awx_credential_kubernetes_bearer_token":                  resourceCredentialKubernetesBearerToken(),
+			"awx_credential_hashicorp_vault_secret_lookup":            resourceCredentialHashiCorpVaultSecretLookup(),
+			"awx_credential_hashicorp_vault_signed_ssh":               resourceCredentialHashiCorpVaultSignedSSH(),
+			"awx_credential_cyberark_central_credential_provider":     resourceCredentialCyberArkCentralCredentialProvider(),
+			"awx_credential_thycotic_secret_server":                   resourceCredentialThycoticSecretServer(),
 			"awx_execution_environment":                               resourceExecutionEnvironment(),
 			"awx_host":                                                resourceHost(),
 			"awx_instance_group":                                      resourceInstanceGroup(),
@@ -75,6 +93,7 @@ func Provider() *schema.Provider { //nolint:funlen
 			"awx_job_template_instance_groups":                        resourceJobTemplateInstanceGroups(),
 			"awx_job_template":                                        resourceJobTemplate(),
 			"awx_job_template_survey":                                 resourceJobTemplateSurvey(),
+			"awx_ad_hoc_command":                                      resourceAdHocCommand(),
 			"awx_job_template_launch":                                 resourceJobTemplateLaunch(),
 			"awx_job_template_notification_template_error":            resourceJobTemplateNotificationTemplateError(),
 			"awx_job_template_notification_template_started":          resourceJobTemplateNotificationTemplateStarted(),
@@ -93,6 +112,7 @@ func Provider() *schema.Provider { //nolint:funlen
 			"awx_workflow_job_template_node_success":                  resourceWorkflowJobTemplateNodeSuccess(),
 			"awx_workflow_job_template_node":                          resourceWorkflowJobTemplateNode(),
 			"awx_workflow_job_template":                               resourceWorkflowJobTemplate(),
+			"awx_workflow_job_template_launch":                        resourceWorkflowJobTemplateLaunch(),
 			"awx_workflow_job_template_schedule":                      resourceWorkflowJobTemplateSchedule(),
 			"awx_workflow_job_template_notification_template_error":   resourceWorkflowJobTemplateNotificationTemplateError(),
 			"awx_workflow_job_template_notification_template_started": resourceWorkflowJobTemplateNotificationTemplateStarted(),
@@ -103,11 +123,13 @@ func Provider() *schema.Provider { //nolint:funlen
 			"awx_credential":                 dataSourceCredentialByID(),
 			"awx_credential_role":            dataSourceCredentialMachineRole(),
 			"awx_credential_type":            dataSourceCredentialTypeByID(),
+			"awx_credential_types":           dataSourceCredentialTypes(),
 			"awx_credentials":                dataSourceCredentials(),
 			"awx_execution_environment":      dataSourceExecutionEnvironment(),
 			"awx_inventory_group":            dataSourceInventoryGroup(),
 			"awx_inventory":                  dataSourceInventory(),
 			"awx_inventory_role":             dataSourceInventoryRole(),
+			"awx_job_stdout":                 dataSourceJobStdout(),
 			"awx_job_template":               dataSourceJobTemplate(),
 			"awx_job_template_role":          dataSourceJobTemplateRole(),
 			"awx_notification_template":      dataSourceNotificationTemplate(),
@@ -178,6 +200,7 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 		})
 		return nil, diags
 	}
+	c.AdoptExisting = d.Get("adopt_existing").(bool)
 
 	return c, diags
 }
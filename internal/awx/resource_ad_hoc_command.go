@@ -0,0 +1,261 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
+)
+
+const diagAdHocCommandTitle = "Ad Hoc Command"
+
+//nolint:funlen
+func resourceAdHocCommand() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource `awx_ad_hoc_command` runs a single Ansible module on the hosts of an inventory through " +
+			"AWX, such as `ping` or `setup`. A change of its arguments runs a new command, replacing the resource.",
+		CreateContext: resourceAdHocCommandCreate,
+		ReadContext:   resourceAdHocCommandRead,
+		UpdateContext: resourceAdHocCommandRead,
+		DeleteContext: resourceAdHocCommandDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAdHocCommandImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"inventory_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the inventory whose hosts the module runs on.",
+			},
+			"module_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the module to run, among the ad hoc commands allowed by the AWX settings, such as `ping` or `setup`.",
+			},
+			"module_args": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The arguments of the module.",
+			},
+			"limit": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of comma delimited hosts or patterns to limit the command to.",
+			},
+			"credential_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the machine credential to connect to the hosts with.",
+			},
+			"become_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Run the module with privilege escalation.",
+			},
+			"forks": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of hosts the module runs on in parallel, 0 for the Ansible default.",
+			},
+			"verbosity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 5),
+				Description:  "The verbosity, from 0 (normal) to 5 (WinRM debug).",
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values which run a new command when any of them changes, such as the ID of the " +
+					"hosts being provisioned.",
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+				Description: "Running the command will wait for its completion, and fail with the command outcome, " +
+					"explanation, URL and failing hosts when the command fails, errors, is canceled or the timeout is reached.",
+			},
+			"cancel_on_destroy": cancelOnDestroySchema(),
+			"cancel_on_timeout": cancelOnTimeoutSchema(),
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the command when it was last read, such as `running` or `successful`.",
+			},
+			"started": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the command started, in RFC 3339 format.",
+			},
+			"finished": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the command finished, in RFC 3339 format.",
+			},
+			"elapsed": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The run time of the command in seconds.",
+			},
+			"failed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the command failed.",
+			},
+			"job_explanation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Why the command is in its status, such as the reason of an error.",
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceAdHocCommandCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+
+	inventoryID := d.Get("inventory_id").(int)
+	data := map[string]interface{}{
+		"module_name":    d.Get("module_name").(string),
+		"module_args":    d.Get("module_args").(string),
+		"limit":          d.Get("limit").(string),
+		"become_enabled": d.Get("become_enabled").(bool),
+		"forks":          d.Get("forks").(int),
+		"verbosity":      d.Get("verbosity").(int),
+	}
+	if v, ok := d.GetOk("credential_id"); ok {
+		data["credential"] = v.(int)
+	}
+
+	res, err := client.AdHocCommandService.LaunchInventoryAdHocCommand(inventoryID, data, map[string]string{})
+	if err != nil {
+		return utils.DiagCreate(diagAdHocCommandTitle, err)
+	}
+	d.SetId(strconv.Itoa(res.ID))
+
+	if d.Get("wait_for_completion").(bool) {
+		opts := &awx.JobWaitOptions{Timeout: d.Timeout(schema.TimeoutCreate)}
+		if _, err := client.AdHocCommandService.WaitForAdHocCommand(ctx, res.ID, opts); err != nil {
+			diags := append(diagJobWait("AdHocCommand", fmt.Sprintf("inventory ID %d", inventoryID), err, func(id int) string {
+				return adHocCommandFailureSummary(client, id)
+			}), cancelJobOnTimeout(ctx, d, adHocCommandCanceler(client), res.ID, err)...)
+			return append(diags, resourceAdHocCommandRead(ctx, d, m)...)
+		}
+	}
+	return resourceAdHocCommandRead(ctx, d, m)
+}
+
+func resourceAdHocCommandRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	id, diags := utils.StateIDToInt("Read Ad Hoc Command", d)
+	if diags.HasError() {
+		return diags
+	}
+
+	cmd, err := client.AdHocCommandService.GetAdHocCommand(id, map[string]string{})
+	if awx.IsNotFound(err) {
+		return diagJobPurged("Ad Hoc Command", id, "Its last known results are kept.")
+	}
+	if err != nil {
+		return utils.DiagNotFound(diagAdHocCommandTitle, id, err)
+	}
+	return setAdHocCommandResourceData(d, cmd)
+}
+
+// resourceAdHocCommandImport sets the arguments of an imported command from AWX. Read leaves
+// them alone so that the values AWX normalizes never plan a new command.
+func resourceAdHocCommandImport(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*awx.AWX)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("unexpected format of Ad Hoc Command ID (%s), expected a numeric ID", d.Id())
+	}
+
+	cmd, err := client.AdHocCommandService.GetAdHocCommand(id, map[string]string{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch Ad Hoc Command %d, got %w", id, err)
+	}
+	for k, v := range map[string]interface{}{
+		"inventory_id":   cmd.Inventory,
+		"module_name":    cmd.ModuleName,
+		"module_args":    cmd.ModuleArgs,
+		"limit":          cmd.Limit,
+		"credential_id":  cmd.Credential,
+		"become_enabled": cmd.BecomeEnabled,
+		"forks":          cmd.Forks,
+		"verbosity":      cmd.Verbosity,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return nil, fmt.Errorf("unable to set %s for Ad Hoc Command %d, got %w", k, id, err)
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func setAdHocCommandResourceData(d *schema.ResourceData, cmd *awx.AdHocCommand) diag.Diagnostics {
+	for k, v := range map[string]interface{}{
+		"status":          cmd.Status,
+		"started":         formatJobTime(cmd.Started),
+		"finished":        formatJobTime(cmd.Finished),
+		"elapsed":         cmd.Elapsed,
+		"failed":          cmd.Failed,
+		"job_explanation": cmd.JobExplanation,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return utils.DiagSet(k, cmd.ID, err)
+		}
+	}
+	return nil
+}
+
+func resourceAdHocCommandDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	id, diags := utils.StateIDToInt("Delete Ad Hoc Command", d)
+	if diags.HasError() {
+		return diags
+	}
+	_, err := client.AdHocCommandService.GetAdHocCommand(id, map[string]string{})
+	if awx.IsNotFound(err) {
+		d.SetId("")
+		return diagJobPurged("Ad Hoc Command", id, "It is removed from the state.")
+	}
+	if err != nil {
+		return utils.DiagNotFound(diagAdHocCommandTitle, id, err)
+	}
+	if d.Get("cancel_on_destroy").(bool) {
+		if err := cancelJob(ctx, adHocCommandCanceler(client), id, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return utils.Diagf("Unable to cancel Ad Hoc Command", "Unable to cancel ad hoc command %d before destroying it, got %s", id, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
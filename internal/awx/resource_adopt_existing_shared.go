@@ -0,0 +1,58 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
+)
+
+func adoptExistingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Description: "Take over an existing object with the same name instead of failing to create it, " +
+			"the object is then updated to match the configuration. Defaults to the provider `adopt_existing` setting.",
+	}
+}
+
+// adoptExistingEnabled returns the `adopt_existing` value of the resource, falling back to
+// the provider setting when it is not configured.
+func adoptExistingEnabled(d *schema.ResourceData, m interface{}) bool {
+	if v := d.GetRawConfig().GetAttr("adopt_existing"); v.IsKnown() && !v.IsNull() {
+		return v.True()
+	}
+	return m.(*awx.AWX).AdoptExisting
+}
+
+// adoptExisting takes over the existing object matching params when adoption is enabled,
+// recording its ID and reconciling it to the configuration through update. It reports
+// whether the create is done, either because the object was adopted or the lookup failed.
+func adoptExisting(ctx context.Context, d *schema.ResourceData, m interface{}, kind string, list naturalKeyLister,
+	params map[string]string, update schema.UpdateContextFunc) (bool, diag.Diagnostics) {
+	if !adoptExistingEnabled(d, m) {
+		return false, nil
+	}
+
+	ids, err := list(m.(*awx.AWX), params)
+	if err != nil {
+		return true, utils.DiagFetch(kind, params["name"], err)
+	}
+	switch len(ids) {
+	case 0:
+		return false, nil
+	case 1:
+		log.Printf("[INFO] Adopting existing %s %s with id %d", kind, params["name"], ids[0])
+		d.SetId(strconv.Itoa(ids[0]))
+		return true, update(ctx, d, m)
+	}
+	return true, utils.Diagf(
+		fmt.Sprintf("Unable to adopt %s", kind),
+		"%s with name %s matches several objects %v, got filters %v", kind, params["name"], ids, params,
+	)
+}
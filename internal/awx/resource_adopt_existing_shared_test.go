@@ -0,0 +1,65 @@
+package awx
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+)
+
+func TestAdoptExisting(t *testing.T) {
+	f := awxtest.NewFakeAWX(1)
+	org := f.Add("organizations", map[string]interface{}{"name": "Default"})
+	f.Add("organizations", map[string]interface{}{"name": "twice"})
+	f.Add("organizations", map[string]interface{}{"name": "twice"})
+
+	cases := []struct {
+		name     string
+		config   map[string]cty.Value
+		provider bool
+		done     bool
+		id       string
+		err      string
+	}{
+		{name: "one match", config: map[string]cty.Value{"name": cty.StringVal("Default"), "adopt_existing": cty.True}, done: true, id: strconv.Itoa(org)},
+		{name: "provider default", config: map[string]cty.Value{"name": cty.StringVal("Default")}, provider: true, done: true, id: strconv.Itoa(org)},
+		{name: "no match", config: map[string]cty.Value{"name": cty.StringVal("missing"), "adopt_existing": cty.True}},
+		{name: "several matches", config: map[string]cty.Value{"name": cty.StringVal("twice"), "adopt_existing": cty.True}, done: true, err: "matches several objects"},
+		{name: "disabled", config: map[string]cty.Value{"name": cty.StringVal("Default"), "adopt_existing": cty.False}, provider: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := awxtest.NewClient(t, f)
+			client.AdoptExisting = tc.provider
+			d := testResourceDataConfig(t, resourceOrganization(), tc.config)
+			updated := false
+			update := func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+				updated = true
+				return nil
+			}
+
+			done, diags := adoptExisting(context.Background(), d, client, "organization", listOrganizationIDs,
+				map[string]string{"name": d.Get("name").(string)}, update)
+			if done != tc.done {
+				t.Fatalf("Expecting the create to be done %t but got %t", tc.done, done)
+			}
+			if tc.err != "" {
+				if !diags.HasError() || !strings.Contains(diags[0].Detail, tc.err) {
+					t.Fatalf("Expecting an error containing %q but got %v", tc.err, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics %v", diags)
+			}
+			if d.Id() != tc.id || updated != (tc.id != "") {
+				t.Fatalf("Expecting the ID %q to be adopted but got %q, updated %t", tc.id, d.Id(), updated)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package awx
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
+)
+
+// associationImporter imports an association resource from a `<parent_id>:<child_id>` ID,
+// populating the parent and child attributes so that Read can verify the association.
+func associationImporter(parentKey, childKey string) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+			parentID, childID, err := utils.ParseCompositeID(d.Id())
+			if err != nil {
+				return nil, err
+			}
+			if err := d.Set(parentKey, parentID); err != nil {
+				return nil, err
+			}
+			if err := d.Set(childKey, childID); err != nil {
+				return nil, err
+			}
+			d.SetId(utils.CompositeID(parentID, childID))
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// readAssociation checks that the child is still associated to the parent, calling exists with
+// a filter on the child ID, and removes the resource from the state when it is not or when the
+// parent is gone.
+func readAssociation(d *schema.ResourceData, title, parentKey, childKey string, exists func(parentID int, params map[string]string) (bool, error)) diag.Diagnostics {
+	parentID := d.Get(parentKey).(int)
+	childID := d.Get(childKey).(int)
+
+	found, err := exists(parentID, map[string]string{"id": strconv.Itoa(childID)})
+	if awx.IsNotFound(err) {
+		log.Printf("[WARN] %s parent %d no longer exists, removing the association to %d from the state", title, parentID, childID)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return utils.DiagFetch(title, utils.CompositeID(parentID, childID), err)
+	}
+	if !found {
+		log.Printf("[WARN] %s %d is no longer associated to %d, removing it from the state", title, childID, parentID)
+		d.SetId("")
+		return nil
+	}
+
+	// Resources created by older versions of the provider stored the (meaningless) ID
+	// returned by the associate call, move them over to the composite format.
+	d.SetId(utils.CompositeID(parentID, childID))
+	return nil
+}
@@ -0,0 +1,90 @@
+package awx
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+func TestReadAssociationParentNotFound(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceJobTemplateCredentials().Schema, map[string]interface{}{
+		"job_template_id": 7,
+		"credential_id":   5,
+	})
+	d.SetId("7:5")
+
+	diags := readAssociation(d, "JobTemplate Credential", "job_template_id", "credential_id", func(int, map[string]string) (bool, error) {
+		return false, &awx.ResponseError{StatusCode: http.StatusNotFound}
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics %v", diags)
+	}
+	if d.Id() != "" {
+		t.Fatalf("Expecting the association of a deleted parent to be removed from the state but got %q", d.Id())
+	}
+}
+
+func TestAssociationImporter(t *testing.T) {
+	importer := associationImporter("job_template_id", "credential_id")
+	for id, want := range map[string]string{
+		"7:5":  "",
+		"7":    "unexpected format of ID (7), expected <parent_id>:<child_id>",
+		"7:5:": "unexpected format of ID (7:5:), expected <parent_id>:<child_id>",
+		"a:5":  `parent ID "a" in a:5 is not numeric`,
+		"7:b":  `child ID "b" in 7:b is not numeric`,
+	} {
+		d := resourceJobTemplateCredentials().TestResourceData()
+		d.SetId(id)
+		_, err := importer.StateContext(context.Background(), d, nil)
+		if want != "" {
+			if err == nil || !strings.Contains(err.Error(), want) {
+				t.Errorf("Expecting an error containing %q for %s but got %v", want, id, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.Id() != "7:5" || d.Get("job_template_id") != 7 || d.Get("credential_id") != 5 {
+			t.Fatalf("Expecting the association of 5 to 7 but got %s, %v, %v", d.Id(), d.Get("job_template_id"), d.Get("credential_id"))
+		}
+	}
+}
+
+func TestReadAssociation(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		id    string
+		found bool
+		want  string
+	}{
+		{name: "associated", id: "7:5", found: true, want: "7:5"},
+		{name: "ID of an older version", id: "31", found: true, want: "7:5"},
+		{name: "no longer associated", id: "7:5", found: false, want: ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceJobTemplateCredentials().Schema, map[string]interface{}{
+				"job_template_id": 7,
+				"credential_id":   5,
+			})
+			d.SetId(tc.id)
+
+			diags := readAssociation(d, "JobTemplate Credential", "job_template_id", "credential_id", func(parentID int, params map[string]string) (bool, error) {
+				if parentID != 7 || params["id"] != "5" {
+					t.Errorf("Expecting credential 5 of job template 7 to be looked up but got %d, %v", parentID, params)
+				}
+				return tc.found, nil
+			})
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics %v", diags)
+			}
+			if d.Id() != tc.want {
+				t.Fatalf("Expecting the ID %q but got %q", tc.want, d.Id())
+			}
+		})
+	}
+}
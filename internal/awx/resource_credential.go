@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
 	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
@@ -12,6 +13,9 @@ import (
 
 const diagCredentialTitle = "Credential"
 
+// credentialEncryptedValue is what AWX returns in place of secret credential inputs.
+const credentialEncryptedValue = "$encrypted$"
+
 func resourceCredential() *schema.Resource {
 	return &schema.Resource{
 		Description:   "The `awx_credential` resource allows you to create and manage credentials in Ansible Tower.",
@@ -19,7 +23,18 @@ func resourceCredential() *schema.Resource {
 		ReadContext:   resourceCredentialRead,
 		UpdateContext: resourceCredentialUpdate,
 		DeleteContext: resourceCredentialDelete,
-		Schema: map[string]*schema.Schema{
+		Importer:      organizationNaturalKeyImporter("credential", listCredentialIDs),
+		CustomizeDiff: customdiff.All(customizeDiffCredentialInputs, customizeDiffCredentialInputSecrets),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceCredentialV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceCredentialStateUpgradeV0,
+			},
+		},
+		Schema: withCredentialSecretSchemas(map[string]*schema.Schema{
+			"adopt_existing": adoptExistingSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -40,25 +55,67 @@ func resourceCredential() *schema.Resource {
 				Required:    true,
 				Description: "Specify the type of credential you want to create. Refer to the Ansible Tower documentation for details on each type",
 			},
-			"inputs": {
-				Type:        schema.TypeMap,
-				Required:    true,
-				Sensitive:   true,
-				Description: "The inputs to be created with the credential.",
-			},
+			"inputs": credentialInputsSchema(
+				"The inputs of the credential as a JSON object, such as the result of `jsonencode`, validated against "+
+					"the fields of the credential type. Numbers must be given as strings, such as `\"8200\"`. "+
+					"Before v1.2.0 `inputs` was a map, configurations must wrap it in `jsonencode` when upgrading while the "+
+					"state is upgraded automatically.", false),
+			"sensitive_inputs": credentialInputsSchema(
+				"The secret inputs of the credential as a JSON object, hidden from the plan output. "+
+					"An input may only be set in one of `inputs` and `sensitive_inputs`.", true),
+		}),
+	}
+}
+
+// resourceCredentialV0 is the schema of `awx_credential` with its inputs as a map of strings.
+func resourceCredentialV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"adopt_existing":     {Type: schema.TypeBool, Optional: true},
+			"name":               {Type: schema.TypeString, Required: true},
+			"description":        {Type: schema.TypeString, Optional: true},
+			"organization_id":    {Type: schema.TypeInt, Required: true},
+			"credential_type_id": {Type: schema.TypeInt, Required: true},
+			"inputs":             {Type: schema.TypeMap, Required: true, Sensitive: true},
+			"secret_hashes":      {Type: schema.TypeMap, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"modified":           {Type: schema.TypeString, Computed: true},
 		},
 	}
 }
 
+// resourceCredentialStateUpgradeV0 moves the inputs map, which was sensitive as a whole,
+// to `sensitive_inputs` so that upgrading does not reveal any secret in the plan output.
+func resourceCredentialStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	inputs, _ := rawState["inputs"].(map[string]interface{})
+	sensitiveInputs, err := encodeCredentialInputs(inputs)
+	if err != nil {
+		return nil, err
+	}
+	rawState["inputs"] = ""
+	rawState["sensitive_inputs"] = sensitiveInputs
+	return rawState, nil
+}
+
 func resourceCredentialCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	inputsMap := d.Get("inputs")
+	if done, diags := adoptExisting(ctx, d, m, "credential", listCredentialIDs, map[string]string{
+		"name":            d.Get("name").(string),
+		"organization":    strconv.Itoa(d.Get("organization_id").(int)),
+		"credential_type": strconv.Itoa(d.Get("credential_type_id").(int)),
+	}, resourceCredentialUpdate); done {
+		return diags
+	}
+
+	inputs, err := mergeCredentialInputs(d.Get("inputs").(string), d.Get("sensitive_inputs").(string))
+	if err != nil {
+		return utils.DiagCreate(diagCredentialTitle, err)
+	}
 
 	payload := map[string]interface{}{
 		"name":            d.Get("name").(string),
 		"description":     d.Get("description").(string),
 		"organization":    d.Get("organization_id").(int),
 		"credential_type": d.Get("credential_type_id").(int),
-		"inputs":          inputsMap,
+		"inputs":          inputs,
 	}
 
 	client := m.(*awx.AWX)
@@ -68,8 +125,10 @@ func resourceCredentialCreate(ctx context.Context, d *schema.ResourceData, m int
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
-	resourceCredentialRead(ctx, d, m)
-	return diag.Diagnostics{}
+	if err := recordCredentialSecrets(d, cred, payload, credentialSecretKeys(cred)...); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCredentialRead(ctx, d, m)
 }
 
 func resourceCredentialRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -92,11 +151,42 @@ func resourceCredentialRead(_ context.Context, d *schema.ResourceData, m interfa
 	if err := d.Set("organization_id", cred.OrganizationID); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("inputs", cred.Inputs); err != nil {
+
+	// Secret inputs are only returned as $encrypted$: keep the value known to Terraform,
+	// and leave them out after an import until the configuration provides them. Inputs
+	// stay in the attribute holding them, new ones go to the non sensitive one.
+	known, err := mergeCredentialInputs(d.Get("inputs").(string), d.Get("sensitive_inputs").(string))
+	if err != nil {
 		return diag.FromErr(err)
 	}
+	knownSensitive, _ := decodeCredentialInputs(d.Get("sensitive_inputs").(string))
+	inputs := make(map[string]interface{}, len(cred.Inputs))
+	sensitiveInputs := make(map[string]interface{})
+	for k, v := range cred.Inputs {
+		if v == credentialEncryptedValue {
+			value, ok := known[k]
+			if !ok {
+				continue
+			}
+			v = value
+		}
+		if _, ok := knownSensitive[k]; ok {
+			sensitiveInputs[k] = v
+		} else {
+			inputs[k] = v
+		}
+	}
+	for key, value := range map[string]map[string]interface{}{"inputs": inputs, "sensitive_inputs": sensitiveInputs} {
+		encoded, err := encodeCredentialInputs(value)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set(key, encoded); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
-	return diag.Diagnostics{}
+	return checkCredentialModified(d, cred)
 }
 
 func resourceCredentialUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -105,29 +195,56 @@ func resourceCredentialUpdate(ctx context.Context, d *schema.ResourceData, m int
 		"description",
 		"organization_id",
 		"inputs",
+		"sensitive_inputs",
+		"secret_hashes",
 	}
 
 	if d.HasChanges(keys...) {
-		var err error
-
-		inputsMap := d.Get("inputs")
-
 		id, err := strconv.Atoi(d.Id())
 		if err != nil {
 			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
 		}
+		client := m.(*awx.AWX)
+		cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
+		}
+
+		// Secrets AWX holds which Terraform never knew about, as after an import, are
+		// sent back masked so AWX keeps them rather than dropping them from the inputs.
+		// So are the secrets matching their recorded hash, which AWX already holds.
+		inputs, err := mergeCredentialInputs(d.Get("inputs").(string), d.Get("sensitive_inputs").(string))
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
+		}
+		oldInputs, _ := d.GetChange("inputs")
+		oldSensitiveInputs, _ := d.GetChange("sensitive_inputs")
+		previous, _ := mergeCredentialInputs(oldInputs.(string), oldSensitiveInputs.(string))
+		hashes := recordedCredentialSecretHashes(d)
+		for _, k := range credentialSecretKeys(cred) {
+			_, wasKnown := previous[k]
+			value, isKnown := inputs[k]
+			secret, _ := value.(string)
+			if !wasKnown && !isKnown || isKnown && credentialSecretMatches(hashes[k], secret) {
+				inputs[k] = credentialEncryptedValue
+			}
+		}
+
 		update := map[string]interface{}{
 			"name":            d.Get("name").(string),
 			"description":     d.Get("description").(string),
 			"organization":    d.Get("organization_id").(int),
 			"credential_type": d.Get("credential_type_id"),
-			"inputs":          inputsMap,
+			"inputs":          inputs,
 		}
 
-		client := m.(*awx.AWX)
-		if _, err = client.CredentialsService.UpdateCredentialsByID(id, update, map[string]string{}); err != nil {
+		updated, err := client.CredentialsService.UpdateCredentialsByID(id, update, map[string]string{})
+		if err != nil {
 			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
 		}
+		if err := recordCredentialSecrets(d, updated, update, credentialSecretKeys(cred)...); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceCredentialRead(ctx, d, m)
@@ -145,3 +262,43 @@ func resourceCredentialDelete(_ context.Context, d *schema.ResourceData, m inter
 
 	return diag.Diagnostics{}
 }
+
+// setCredentialSecret sets a secret attribute from the credential inputs read from AWX.
+// AWX only returns $encrypted$ for those, so the value in state is kept instead: the
+// last one written by Terraform, or empty right after an import.
+func setCredentialSecret(d *schema.ResourceData, key string, value interface{}) error {
+	if value == credentialEncryptedValue {
+		return nil
+	}
+	return d.Set(key, value)
+}
+
+// keepCredentialSecrets sends $encrypted$ back, so that AWX keeps its value, for the secret
+// inputs of the update payload which Terraform already sent: those matching their recorded
+// hash, or unchanged in Terraform when none is recorded. A secret whose hash was emptied after
+// a change outside of Terraform is sent again. Sending the empty value of an imported
+// credential would otherwise wipe the secret.
+func keepCredentialSecrets(d *schema.ResourceData, client *awx.AWX, id int, payload map[string]interface{}, keys ...string) error {
+	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	if err != nil {
+		return err
+	}
+
+	hashes := recordedCredentialSecretHashes(d)
+	inputs := payload["inputs"].(map[string]interface{})
+	for _, key := range keys {
+		if cred.Inputs[key] != credentialEncryptedValue {
+			continue
+		}
+		value, _ := inputs[key].(string)
+		hash, recorded := hashes[key]
+		if recorded && value != "" {
+			if credentialSecretMatches(hash, value) {
+				inputs[key] = credentialEncryptedValue
+			}
+		} else if !d.HasChange(key) {
+			inputs[key] = credentialEncryptedValue
+		}
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package awx
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
+)
+
+const diagCredentialAmazonWebServicesTitle = "Amazon Web Services Credential"
+
+func resourceCredentialAmazonWebServices() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`awx_credential_amazon_web_services` manages Amazon Web Services credentials in AWX.",
+		CreateContext: resourceCredentialAmazonWebServicesCreate,
+		ReadContext:   resourceCredentialAmazonWebServicesRead,
+		UpdateContext: resourceCredentialAmazonWebServicesUpdate,
+		DeleteContext: resourceCredentialDelete,
+		Importer:      organizationNaturalKeyImporter("credential", listCredentialIDs),
+		CustomizeDiff: customizeDiffCredentialSecrets("password", "security_token"),
+		Schema: withCredentialSecretSchemas(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the credential.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the credential.",
+			},
+			"organization_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The organization ID this credential belongs to.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The access key of the credential.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The secret key of the credential.",
+			},
+			"security_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The STS token of the credential, for temporary credentials.",
+			},
+		}),
+	}
+}
+
+func resourceCredentialAmazonWebServicesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	credentialTypeID, err := resolveManagedCredentialType(client, awsCredentialType)
+	if err != nil {
+		return utils.DiagCreate(diagCredentialAmazonWebServicesTitle, err)
+	}
+
+	payload := map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"description":     d.Get("description").(string),
+		"organization":    d.Get("organization_id").(int),
+		"credential_type": credentialTypeID,
+		"inputs": map[string]interface{}{
+			"username":       d.Get("username").(string),
+			"password":       d.Get("password").(string),
+			"security_token": d.Get("security_token").(string),
+		},
+	}
+
+	cred, err := client.CredentialsService.CreateCredentials(payload, map[string]string{})
+	if err != nil {
+		return utils.DiagCreate(diagCredentialAmazonWebServicesTitle, err)
+	}
+
+	d.SetId(strconv.Itoa(cred.ID))
+	if err := recordCredentialSecrets(d, cred, payload, "password", "security_token"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCredentialAmazonWebServicesRead(ctx, d, m)
+}
+
+func resourceCredentialAmazonWebServicesRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return utils.DiagFetch(diagCredentialAmazonWebServicesTitle, d.Id(), err)
+	}
+	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	if err != nil {
+		return utils.DiagFetch(diagCredentialAmazonWebServicesTitle, d.Id(), err)
+	}
+
+	if err := d.Set("name", cred.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", cred.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("organization_id", cred.OrganizationID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("username", cred.Inputs["username"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCredentialSecret(d, "password", cred.Inputs["password"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCredentialSecret(d, "security_token", cred.Inputs["security_token"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return checkCredentialModified(d, cred)
+}
+
+func resourceCredentialAmazonWebServicesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	keys := []string{
+		"name",
+		"description",
+		"organization_id",
+		"username",
+		"password",
+		"security_token",
+		"secret_hashes",
+	}
+
+	if d.HasChanges(keys...) {
+		id, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialAmazonWebServicesTitle, d.Id(), err)
+		}
+		client := m.(*awx.AWX)
+		credentialTypeID, err := resolveManagedCredentialType(client, awsCredentialType)
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialAmazonWebServicesTitle, d.Id(), err)
+		}
+
+		payload := map[string]interface{}{
+			"name":            d.Get("name").(string),
+			"description":     d.Get("description").(string),
+			"organization":    d.Get("organization_id").(int),
+			"credential_type": credentialTypeID,
+			"inputs": map[string]interface{}{
+				"username":       d.Get("username").(string),
+				"password":       d.Get("password").(string),
+				"security_token": d.Get("security_token").(string),
+			},
+		}
+		if err := keepCredentialSecrets(d, client, id, payload, "password", "security_token"); err != nil {
+			return utils.DiagUpdate(diagCredentialAmazonWebServicesTitle, d.Id(), err)
+		}
+
+		updated, err := client.CredentialsService.UpdateCredentialsByID(id, payload, map[string]string{})
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialAmazonWebServicesTitle, d.Id(), err)
+		}
+		if err := recordCredentialSecrets(d, updated, payload, "password", "security_token"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCredentialAmazonWebServicesRead(ctx, d, m)
+}
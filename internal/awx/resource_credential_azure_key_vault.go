@@ -17,7 +17,9 @@ func resourceCredentialAzureKeyVault() *schema.Resource {
 		ReadContext:   resourceCredentialAzureKeyVaultRead,
 		UpdateContext: resourceCredentialAzureKeyVaultUpdate,
 		DeleteContext: resourceCredentialDelete,
-		Schema: map[string]*schema.Schema{
+		Importer:      organizationNaturalKeyImporter("credential", listCredentialIDs),
+		CustomizeDiff: customizeDiffCredentialSecrets("secret"),
+		Schema: withCredentialSecretSchemas(map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -33,6 +35,9 @@ func resourceCredentialAzureKeyVault() *schema.Resource {
 				Required:    true,
 				Description: "The organization ID that the credential belongs to.",
 			},
+			"validate_on_create": validateOnCreateSchema(
+				"Check that AWX can look up the secret described by `validation_metadata` before creating the credential."),
+			"validation_metadata": validationMetadataSchema(),
 			"url": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -54,16 +59,21 @@ func resourceCredentialAzureKeyVault() *schema.Resource {
 				Required:    true,
 				Description: "The tenant ID of the Azure Key Vault.",
 			},
-		},
+		}),
 	}
 }
 
 func resourceCredentialAzureKeyVaultCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	credentialTypeID, err := resolveManagedCredentialType(m.(*awx.AWX), azureKeyVaultCredentialType)
+	if err != nil {
+		return utils.DiagCreate(diagCredentialTitle, err)
+	}
+
 	payload := map[string]interface{}{
 		"name":            d.Get("name").(string),
 		"description":     d.Get("description").(string),
 		"organization":    d.Get("organization_id").(int),
-		"credential_type": 19, // Azure Key Vault
+		"credential_type": credentialTypeID,
 		"inputs": map[string]interface{}{
 			"url":    d.Get("url").(string),
 			"client": d.Get("client").(string),
@@ -73,15 +83,20 @@ func resourceCredentialAzureKeyVaultCreate(ctx context.Context, d *schema.Resour
 	}
 
 	client := m.(*awx.AWX)
+	if err := validateLookupCredentialOnCreate(d, client, credentialTypeID, payload); err != nil {
+		return utils.DiagCreate(diagCredentialTitle, err)
+	}
+
 	cred, err := client.CredentialsService.CreateCredentials(payload, map[string]string{})
 	if err != nil {
 		return utils.DiagCreate("Azure Key Vault Credential", err)
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
-	resourceCredentialAzureKeyVaultRead(ctx, d, m)
-
-	return diag.Diagnostics{}
+	if err := recordCredentialSecrets(d, cred, payload, "secret"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCredentialAzureKeyVaultRead(ctx, d, m)
 }
 
 func resourceCredentialAzureKeyVaultRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -110,14 +125,14 @@ func resourceCredentialAzureKeyVaultRead(_ context.Context, d *schema.ResourceDa
 	if err := d.Set("client", cred.Inputs["client"]); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("secret", d.Get("secret").(string)); err != nil {
+	if err := setCredentialSecret(d, "secret", cred.Inputs["secret"]); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("tenant", cred.Inputs["tenant"]); err != nil {
 		return diag.FromErr(err)
 	}
 
-	return diag.Diagnostics{}
+	return checkCredentialModified(d, cred)
 }
 
 func resourceCredentialAzureKeyVaultUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -126,8 +141,9 @@ func resourceCredentialAzureKeyVaultUpdate(ctx context.Context, d *schema.Resour
 		"description",
 		"url",
 		"client",
-		//"secret",
+		"secret",
 		"tenant",
+		"secret_hashes",
 	}
 
 	if d.HasChanges(keys...) {
@@ -135,11 +151,16 @@ func resourceCredentialAzureKeyVaultUpdate(ctx context.Context, d *schema.Resour
 		if err != nil {
 			return utils.DiagUpdate("Azure Key Vault Credential", d.Id(), err)
 		}
+		credentialTypeID, err := resolveManagedCredentialType(m.(*awx.AWX), azureKeyVaultCredentialType)
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
+		}
+
 		payload := map[string]interface{}{
 			"name":            d.Get("name").(string),
 			"description":     d.Get("description").(string),
 			"organization":    d.Get("organization_id").(int),
-			"credential_type": 19, // Azure Key Vault
+			"credential_type": credentialTypeID,
 			"inputs": map[string]interface{}{
 				"url":    d.Get("url").(string),
 				"client": d.Get("client").(string),
@@ -148,9 +169,17 @@ func resourceCredentialAzureKeyVaultUpdate(ctx context.Context, d *schema.Resour
 			},
 		}
 		client := m.(*awx.AWX)
-		if _, err = client.CredentialsService.UpdateCredentialsByID(id, payload, map[string]string{}); err != nil {
+		if err := keepCredentialSecrets(d, client, id, payload, "secret"); err != nil {
 			return utils.DiagUpdate("Azure Key Vault Credential", d.Id(), err)
 		}
+
+		updated, err := client.CredentialsService.UpdateCredentialsByID(id, payload, map[string]string{})
+		if err != nil {
+			return utils.DiagUpdate("Azure Key Vault Credential", d.Id(), err)
+		}
+		if err := recordCredentialSecrets(d, updated, payload, "secret"); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceCredentialAzureKeyVaultRead(ctx, d, m)
@@ -0,0 +1,223 @@
+package awx
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
+)
+
+const diagCredentialAzureResourceManagerTitle = "Azure Resource Manager Credential"
+
+//nolint:funlen
+func resourceCredentialAzureResourceManager() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`awx_credential_azure_resource_manager` manages Azure Resource Manager credentials in AWX, authenticating either with a service principal (`client`, `secret` and `tenant`) or with a user (`username` and `password`).",
+		CreateContext: resourceCredentialAzureResourceManagerCreate,
+		ReadContext:   resourceCredentialAzureResourceManagerRead,
+		UpdateContext: resourceCredentialAzureResourceManagerUpdate,
+		DeleteContext: resourceCredentialDelete,
+		Importer:      organizationNaturalKeyImporter("credential", listCredentialIDs),
+		CustomizeDiff: customizeDiffCredentialSecrets("secret", "password"),
+		Schema: withCredentialSecretSchemas(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the credential.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the credential.",
+			},
+			"organization_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The organization ID this credential belongs to.",
+			},
+			"subscription": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The subscription ID of the credential.",
+			},
+			"client": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				RequiredWith:  []string{"secret", "tenant"},
+				ConflictsWith: []string{"username"},
+				Description:   "The client ID of the service principal.",
+			},
+			"secret": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"client"},
+				Description:  "The client secret of the service principal.",
+			},
+			"tenant": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client"},
+				Description:  "The tenant ID of the service principal.",
+			},
+			"username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"password"},
+				Description:  "The username of the user.",
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"username"},
+				Description:  "The password of the user.",
+			},
+			"cloud_environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Azure cloud environment, such as `AzureCloud` or `AzureUSGovernmentCloud`.",
+			},
+		}),
+	}
+}
+
+func resourceCredentialAzureResourceManagerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	credentialTypeID, err := resolveManagedCredentialType(client, azureResourceManagerCredentialType)
+	if err != nil {
+		return utils.DiagCreate(diagCredentialAzureResourceManagerTitle, err)
+	}
+
+	payload := map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"description":     d.Get("description").(string),
+		"organization":    d.Get("organization_id").(int),
+		"credential_type": credentialTypeID,
+		"inputs": map[string]interface{}{
+			"subscription":      d.Get("subscription").(string),
+			"client":            d.Get("client").(string),
+			"secret":            d.Get("secret").(string),
+			"tenant":            d.Get("tenant").(string),
+			"username":          d.Get("username").(string),
+			"password":          d.Get("password").(string),
+			"cloud_environment": d.Get("cloud_environment").(string),
+		},
+	}
+
+	cred, err := client.CredentialsService.CreateCredentials(payload, map[string]string{})
+	if err != nil {
+		return utils.DiagCreate(diagCredentialAzureResourceManagerTitle, err)
+	}
+
+	d.SetId(strconv.Itoa(cred.ID))
+	if err := recordCredentialSecrets(d, cred, payload, "secret", "password"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCredentialAzureResourceManagerRead(ctx, d, m)
+}
+
+func resourceCredentialAzureResourceManagerRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return utils.DiagFetch(diagCredentialAzureResourceManagerTitle, d.Id(), err)
+	}
+	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	if err != nil {
+		return utils.DiagFetch(diagCredentialAzureResourceManagerTitle, d.Id(), err)
+	}
+
+	if err := d.Set("name", cred.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", cred.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("organization_id", cred.OrganizationID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("subscription", cred.Inputs["subscription"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("client", cred.Inputs["client"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCredentialSecret(d, "secret", cred.Inputs["secret"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("tenant", cred.Inputs["tenant"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("username", cred.Inputs["username"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCredentialSecret(d, "password", cred.Inputs["password"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("cloud_environment", cred.Inputs["cloud_environment"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return checkCredentialModified(d, cred)
+}
+
+func resourceCredentialAzureResourceManagerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	keys := []string{
+		"name",
+		"description",
+		"organization_id",
+		"subscription",
+		"client",
+		"secret",
+		"tenant",
+		"username",
+		"password",
+		"cloud_environment",
+		"secret_hashes",
+	}
+
+	if d.HasChanges(keys...) {
+		id, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialAzureResourceManagerTitle, d.Id(), err)
+		}
+		client := m.(*awx.AWX)
+		credentialTypeID, err := resolveManagedCredentialType(client, azureResourceManagerCredentialType)
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialAzureResourceManagerTitle, d.Id(), err)
+		}
+
+		payload := map[string]interface{}{
+			"name":            d.Get("name").(string),
+			"description":     d.Get("description").(string),
+			"organization":    d.Get("organization_id").(int),
+			"credential_type": credentialTypeID,
+			"inputs": map[string]interface{}{
+				"subscription":      d.Get("subscription").(string),
+				"client":            d.Get("client").(string),
+				"secret":            d.Get("secret").(string),
+				"tenant":            d.Get("tenant").(string),
+				"username":          d.Get("username").(string),
+				"password":          d.Get("password").(string),
+				"cloud_environment": d.Get("cloud_environment").(string),
+			},
+		}
+		if err := keepCredentialSecrets(d, client, id, payload, "secret", "password"); err != nil {
+			return utils.DiagUpdate(diagCredentialAzureResourceManagerTitle, d.Id(), err)
+		}
+
+		updated, err := client.CredentialsService.UpdateCredentialsByID(id, payload, map[string]string{})
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialAzureResourceManagerTitle, d.Id(), err)
+		}
+		if err := recordCredentialSecrets(d, updated, payload, "secret", "password"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCredentialAzureResourceManagerRead(ctx, d, m)
+}
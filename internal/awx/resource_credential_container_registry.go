@@ -8,10 +8,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
-const containerRegistryCredentialTypeName = "Container Registry" //nolint:gosec
-
 func resourceCredentialContainerRegistry() *schema.Resource {
 	return &schema.Resource{
 		Description:   "`awx_credential_container_registry` manages container registry credentials in AWX.",
@@ -19,7 +18,9 @@ func resourceCredentialContainerRegistry() *schema.Resource {
 		ReadContext:   resourceCredentialContainerRegistryRead,
 		UpdateContext: resourceCredentialContainerRegistryUpdate,
 		DeleteContext: resourceCredentialDelete,
-		Schema: map[string]*schema.Schema{
+		Importer:      organizationNaturalKeyImporter("credential", listCredentialIDs),
+		CustomizeDiff: customizeDiffCredentialSecrets("password"),
+		Schema: withCredentialSecretSchemas(map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -57,7 +58,7 @@ func resourceCredentialContainerRegistry() *schema.Resource {
 				Default:     true,
 				Description: "Verify SSL",
 			},
-		},
+		}),
 	}
 }
 
@@ -66,21 +67,16 @@ func resourceCredentialContainerRegistryCreate(ctx context.Context, d *schema.Re
 	var err error
 
 	client := m.(*awx.AWX)
-	containerRegistryCredType, err := client.CredentialTypeService.GetCredentialTypeByName(containerRegistryCredentialTypeName, map[string]string{})
+	credentialTypeID, err := resolveManagedCredentialType(client, containerRegistryCredentialType)
 	if err != nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to create new credentials",
-			Detail:   fmt.Sprintf("Unable to fetch credential type with Name: %s. Error: %s", containerRegistryCredentialTypeName, err.Error()),
-		})
-		return diags
+		return utils.DiagCreate(diagCredentialTitle, err)
 	}
 
 	newCredential := map[string]interface{}{
 		"name":            d.Get("name").(string),
 		"description":     d.Get("description").(string),
 		"organization":    d.Get("organization_id").(int),
-		"credential_type": containerRegistryCredType.ID,
+		"credential_type": credentialTypeID,
 		"inputs": map[string]interface{}{
 			"username":   d.Get("username").(string),
 			"password":   d.Get("password").(string),
@@ -100,9 +96,10 @@ func resourceCredentialContainerRegistryCreate(ctx context.Context, d *schema.Re
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
-	resourceCredentialContainerRegistryRead(ctx, d, m)
-
-	return diags
+	if err := recordCredentialSecrets(d, cred, newCredential, "password"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCredentialContainerRegistryRead(ctx, d, m)
 }
 
 func resourceCredentialContainerRegistryRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -132,7 +129,7 @@ func resourceCredentialContainerRegistryRead(_ context.Context, d *schema.Resour
 	if err := d.Set("username", cred.Inputs["username"]); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("password", cred.Inputs["password"]); err != nil {
+	if err := setCredentialSecret(d, "password", cred.Inputs["password"]); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("host", cred.Inputs["host"]); err != nil {
@@ -142,7 +139,7 @@ func resourceCredentialContainerRegistryRead(_ context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	return diags
+	return append(diags, checkCredentialModified(d, cred)...)
 }
 
 func resourceCredentialContainerRegistryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -155,6 +152,7 @@ func resourceCredentialContainerRegistryUpdate(ctx context.Context, d *schema.Re
 		"password",
 		"host",
 		"verify_ssl",
+		"secret_hashes",
 	}
 
 	client := m.(*awx.AWX)
@@ -162,14 +160,9 @@ func resourceCredentialContainerRegistryUpdate(ctx context.Context, d *schema.Re
 	if d.HasChanges(keys...) {
 		var err error
 
-		containerRegistryCredType, err := client.CredentialTypeService.GetCredentialTypeByName(containerRegistryCredentialTypeName, map[string]string{})
+		credentialTypeID, err := resolveManagedCredentialType(client, containerRegistryCredentialType)
 		if err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Unable to create new credentials",
-				Detail:   fmt.Sprintf("Unable to fetch credential type with Name: %s. Error: %s", containerRegistryCredentialTypeName, err.Error()),
-			})
-			return diags
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
 		}
 
 		id, _ := strconv.Atoi(d.Id())
@@ -177,7 +170,7 @@ func resourceCredentialContainerRegistryUpdate(ctx context.Context, d *schema.Re
 			"name":            d.Get("name").(string),
 			"description":     d.Get("description").(string),
 			"organization":    d.Get("organization_id").(int),
-			"credential_type": containerRegistryCredType.ID,
+			"credential_type": credentialTypeID,
 			"inputs": map[string]interface{}{
 				"username":   d.Get("username").(string),
 				"password":   d.Get("password").(string),
@@ -186,7 +179,11 @@ func resourceCredentialContainerRegistryUpdate(ctx context.Context, d *schema.Re
 			},
 		}
 
-		_, err = client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
+		if err := keepCredentialSecrets(d, client, id, updatedCredential, "password"); err != nil {
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
+		}
+
+		updated, err := client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -195,6 +192,9 @@ func resourceCredentialContainerRegistryUpdate(ctx context.Context, d *schema.Re
 			})
 			return diags
 		}
+		if err := recordCredentialSecrets(d, updated, updatedCredential, "password"); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceCredentialContainerRegistryRead(ctx, d, m)
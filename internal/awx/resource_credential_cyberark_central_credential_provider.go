@@ -0,0 +1,203 @@
+package awx
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
+)
+
+const diagCredentialCyberArkCentralCredentialProviderTitle = "CyberArk Central Credential Provider Credential"
+
+//nolint:funlen
+func resourceCredentialCyberArkCentralCredentialProvider() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`awx_credential_cyberark_central_credential_provider` manages CyberArk Central Credential Provider lookup credentials in AWX, a source for `awx_credential_input_source`.",
+		CreateContext: resourceCredentialCyberArkCentralCredentialProviderCreate,
+		ReadContext:   resourceCredentialCyberArkCentralCredentialProviderRead,
+		UpdateContext: resourceCredentialCyberArkCentralCredentialProviderUpdate,
+		DeleteContext: resourceCredentialDelete,
+		Importer:      organizationNaturalKeyImporter("credential", listCredentialIDs),
+		CustomizeDiff: customizeDiffCredentialSecrets("client_key", "client_cert"),
+		Schema: withCredentialSecretSchemas(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the credential.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the credential.",
+			},
+			"organization_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The organization ID this credential belongs to.",
+			},
+			"validate_on_create": validateOnCreateSchema(
+				"Check that AWX can look up the secret described by `validation_metadata` before creating the credential."),
+			"validation_metadata": validationMetadataSchema(),
+			"url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The URL of the CyberArk Central Credential Provider.",
+			},
+			"app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The application ID.",
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded client key.",
+			},
+			"client_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded client certificate.",
+			},
+			"verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Verify the SSL certificate of the Central Credential Provider.",
+			},
+		}),
+	}
+}
+
+func resourceCredentialCyberArkCentralCredentialProviderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	credentialTypeID, err := resolveManagedCredentialType(client, cyberArkCentralCredentialProviderCredentialType)
+	if err != nil {
+		return utils.DiagCreate(diagCredentialCyberArkCentralCredentialProviderTitle, err)
+	}
+
+	payload := map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"description":     d.Get("description").(string),
+		"organization":    d.Get("organization_id").(int),
+		"credential_type": credentialTypeID,
+		"inputs": map[string]interface{}{
+			"url":         d.Get("url").(string),
+			"app_id":      d.Get("app_id").(string),
+			"client_key":  d.Get("client_key").(string),
+			"client_cert": d.Get("client_cert").(string),
+			"verify":      d.Get("verify").(bool),
+		},
+	}
+
+	if err := validateLookupCredentialOnCreate(d, client, credentialTypeID, payload); err != nil {
+		return utils.DiagCreate(diagCredentialCyberArkCentralCredentialProviderTitle, err)
+	}
+
+	cred, err := client.CredentialsService.CreateCredentials(payload, map[string]string{})
+	if err != nil {
+		return utils.DiagCreate(diagCredentialCyberArkCentralCredentialProviderTitle, err)
+	}
+
+	d.SetId(strconv.Itoa(cred.ID))
+	if err := recordCredentialSecrets(d, cred, payload, "client_key", "client_cert"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCredentialCyberArkCentralCredentialProviderRead(ctx, d, m)
+}
+
+func resourceCredentialCyberArkCentralCredentialProviderRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return utils.DiagFetch(diagCredentialCyberArkCentralCredentialProviderTitle, d.Id(), err)
+	}
+	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	if err != nil {
+		return utils.DiagFetch(diagCredentialCyberArkCentralCredentialProviderTitle, d.Id(), err)
+	}
+
+	if err := d.Set("name", cred.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", cred.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("organization_id", cred.OrganizationID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("url", cred.Inputs["url"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("app_id", cred.Inputs["app_id"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCredentialSecret(d, "client_key", cred.Inputs["client_key"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCredentialSecret(d, "client_cert", cred.Inputs["client_cert"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("verify", cred.Inputs["verify"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return checkCredentialModified(d, cred)
+}
+
+func resourceCredentialCyberArkCentralCredentialProviderUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	keys := []string{
+		"name",
+		"description",
+		"organization_id",
+		"url",
+		"app_id",
+		"client_key",
+		"client_cert",
+		"verify",
+		"secret_hashes",
+	}
+
+	if d.HasChanges(keys...) {
+		id, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialCyberArkCentralCredentialProviderTitle, d.Id(), err)
+		}
+		client := m.(*awx.AWX)
+		credentialTypeID, err := resolveManagedCredentialType(client, cyberArkCentralCredentialProviderCredentialType)
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialCyberArkCentralCredentialProviderTitle, d.Id(), err)
+		}
+
+		payload := map[string]interface{}{
+			"name":            d.Get("name").(string),
+			"description":     d.Get("description").(string),
+			"organization":    d.Get("organization_id").(int),
+			"credential_type": credentialTypeID,
+			"inputs": map[string]interface{}{
+				"url":         d.Get("url").(string),
+				"app_id":      d.Get("app_id").(string),
+				"client_key":  d.Get("client_key").(string),
+				"client_cert": d.Get("client_cert").(string),
+				"verify":      d.Get("verify").(bool),
+			},
+		}
+		if err := keepCredentialSecrets(d, client, id, payload, "client_key", "client_cert"); err != nil {
+			return utils.DiagUpdate(diagCredentialCyberArkCentralCredentialProviderTitle, d.Id(), err)
+		}
+
+		updated, err := client.CredentialsService.UpdateCredentialsByID(id, payload, map[string]string{})
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialCyberArkCentralCredentialProviderTitle, d.Id(), err)
+		}
+		if err := recordCredentialSecrets(d, updated, payload, "client_key", "client_cert"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCredentialCyberArkCentralCredentialProviderRead(ctx, d, m)
+}
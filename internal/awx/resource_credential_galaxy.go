@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
 func resourceCredentialGalaxy() *schema.Resource {
@@ -17,7 +18,9 @@ func resourceCredentialGalaxy() *schema.Resource {
 		ReadContext:   resourceCredentialGalaxyRead,
 		UpdateContext: resourceCredentialGalaxyUpdate,
 		DeleteContext: resourceCredentialDelete,
-		Schema: map[string]*schema.Schema{
+		Importer:      organizationNaturalKeyImporter("credential", listCredentialIDs),
+		CustomizeDiff: customizeDiffCredentialSecrets("token"),
+		Schema: withCredentialSecretSchemas(map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -49,7 +52,7 @@ func resourceCredentialGalaxy() *schema.Resource {
 				Sensitive:   true,
 				Description: "The API token for the Ansible Galaxy/Automation Hub API.",
 			},
-		},
+		}),
 	}
 }
 
@@ -57,11 +60,16 @@ func resourceCredentialGalaxyCreate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	var err error
 
+	credentialTypeID, err := resolveManagedCredentialType(m.(*awx.AWX), galaxyCredentialType)
+	if err != nil {
+		return utils.DiagCreate(diagCredentialTitle, err)
+	}
+
 	newCredential := map[string]interface{}{
 		"name":            d.Get("name").(string),
 		"description":     d.Get("description").(string),
 		"organization":    d.Get("organization_id").(int),
-		"credential_type": 18, // Ansible Galaxy/Automation Hub API Token
+		"credential_type": credentialTypeID,
 		"inputs": map[string]interface{}{
 			"url":      d.Get("url").(string),
 			"auth_url": d.Get("auth_url").(string),
@@ -81,9 +89,10 @@ func resourceCredentialGalaxyCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
-	resourceCredentialGalaxyRead(ctx, d, m)
-
-	return diags
+	if err := recordCredentialSecrets(d, cred, newCredential, "token"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCredentialGalaxyRead(ctx, d, m)
 }
 
 func resourceCredentialGalaxyRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -113,14 +122,14 @@ func resourceCredentialGalaxyRead(_ context.Context, d *schema.ResourceData, m i
 	if err := d.Set("auth_url", cred.Inputs["auth_url"]); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("token", cred.Inputs["token"]); err != nil {
+	if err := setCredentialSecret(d, "token", cred.Inputs["token"]); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("organization_id", cred.OrganizationID); err != nil {
 		return diag.FromErr(err)
 	}
 
-	return diags
+	return append(diags, checkCredentialModified(d, cred)...)
 }
 
 func resourceCredentialGalaxyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -135,17 +144,23 @@ func resourceCredentialGalaxyUpdate(ctx context.Context, d *schema.ResourceData,
 		"organization_id",
 		"team_id",
 		"owner_id",
+		"secret_hashes",
 	}
 
 	if d.HasChanges(keys...) {
 		var err error
 
 		id, _ := strconv.Atoi(d.Id())
+		credentialTypeID, err := resolveManagedCredentialType(m.(*awx.AWX), galaxyCredentialType)
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
+		}
+
 		updatedCredential := map[string]interface{}{
 			"name":            d.Get("name").(string),
 			"description":     d.Get("description").(string),
 			"organization":    d.Get("organization_id").(int),
-			"credential_type": 18, // Ansible Galaxy/Automation Hub API Token
+			"credential_type": credentialTypeID,
 			"inputs": map[string]interface{}{
 				"url":      d.Get("url").(string),
 				"auth_url": d.Get("auth_url").(string),
@@ -154,7 +169,11 @@ func resourceCredentialGalaxyUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		client := m.(*awx.AWX)
-		_, err = client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
+		if err := keepCredentialSecrets(d, client, id, updatedCredential, "token"); err != nil {
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
+		}
+
+		updated, err := client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -163,6 +182,9 @@ func resourceCredentialGalaxyUpdate(ctx context.Context, d *schema.ResourceData,
 			})
 			return diags
 		}
+		if err := recordCredentialSecrets(d, updated, updatedCredential, "token"); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceCredentialGalaxyRead(ctx, d, m)
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
 func resourceCredentialGitlab() *schema.Resource {
@@ -17,7 +18,9 @@ func resourceCredentialGitlab() *schema.Resource {
 		ReadContext:   resourceCredentialGitlabRead,
 		UpdateContext: resourceCredentialGitlabUpdate,
 		DeleteContext: resourceCredentialDelete,
-		Schema: map[string]*schema.Schema{
+		Importer:      organizationNaturalKeyImporter("credential", listCredentialIDs),
+		CustomizeDiff: customizeDiffCredentialSecrets("token"),
+		Schema: withCredentialSecretSchemas(map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -39,7 +42,7 @@ func resourceCredentialGitlab() *schema.Resource {
 				Sensitive:   true,
 				Description: "The GitLab Personal Access Token.",
 			},
-		},
+		}),
 	}
 }
 
@@ -47,11 +50,16 @@ func resourceCredentialGitlabCreate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	var err error
 
+	credentialTypeID, err := resolveManagedCredentialType(m.(*awx.AWX), gitlabCredentialType)
+	if err != nil {
+		return utils.DiagCreate(diagCredentialTitle, err)
+	}
+
 	newCredential := map[string]interface{}{
 		"name":            d.Get("name").(string),
 		"description":     d.Get("description").(string),
 		"organization":    d.Get("organization_id").(int),
-		"credential_type": 12, // GitLab Personal Access Token
+		"credential_type": credentialTypeID,
 		"inputs": map[string]interface{}{
 			"token": d.Get("token").(string),
 		},
@@ -69,9 +77,10 @@ func resourceCredentialGitlabCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
-	resourceCredentialGitlabRead(ctx, d, m)
-
-	return diags
+	if err := recordCredentialSecrets(d, cred, newCredential, "token"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCredentialGitlabRead(ctx, d, m)
 }
 
 func resourceCredentialGitlabRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -95,14 +104,14 @@ func resourceCredentialGitlabRead(_ context.Context, d *schema.ResourceData, m i
 	if err := d.Set("description", cred.Description); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("token", cred.Inputs["token"]); err != nil {
+	if err := setCredentialSecret(d, "token", cred.Inputs["token"]); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("organization_id", cred.OrganizationID); err != nil {
 		return diag.FromErr(err)
 	}
 
-	return diags
+	return append(diags, checkCredentialModified(d, cred)...)
 }
 
 func resourceCredentialGitlabUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -113,24 +122,34 @@ func resourceCredentialGitlabUpdate(ctx context.Context, d *schema.ResourceData,
 		"description",
 		"token",
 		"organization_id",
+		"secret_hashes",
 	}
 
 	if d.HasChanges(keys...) {
 		var err error
 
 		id, _ := strconv.Atoi(d.Id())
+		credentialTypeID, err := resolveManagedCredentialType(m.(*awx.AWX), gitlabCredentialType)
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
+		}
+
 		updatedCredential := map[string]interface{}{
 			"name":            d.Get("name").(string),
 			"description":     d.Get("description").(string),
 			"organization":    d.Get("organization_id").(int),
-			"credential_type": 12, // GitLab Personal Access Token
+			"credential_type": credentialTypeID,
 			"inputs": map[string]interface{}{
 				"token": d.Get("token").(string),
 			},
 		}
 
 		client := m.(*awx.AWX)
-		_, err = client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
+		if err := keepCredentialSecrets(d, client, id, updatedCredential, "token"); err != nil {
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
+		}
+
+		updated, err := client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -139,6 +158,9 @@ func resourceCredentialGitlabUpdate(ctx context.Context, d *schema.ResourceData,
 			})
 			return diags
 		}
+		if err := recordCredentialSecrets(d, updated, updatedCredential, "token"); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceCredentialGitlabRead(ctx, d, m)
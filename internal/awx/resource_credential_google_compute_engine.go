@@ -8,10 +8,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
-const gceCredentialTypeName = "Google Compute Engine" //nolint:gosec
-
 func resourceCredentialGoogleComputeEngine() *schema.Resource {
 	return &schema.Resource{
 		Description:   "`awx_credential_google_compute_engine` manages Google Compute Engine credentials in AWX.",
@@ -19,7 +18,9 @@ func resourceCredentialGoogleComputeEngine() *schema.Resource {
 		ReadContext:   resourceCredentialGoogleComputeEngineRead,
 		UpdateContext: resourceCredentialGoogleComputeEngineUpdate,
 		DeleteContext: resourceCredentialDelete,
-		Schema: map[string]*schema.Schema{
+		Importer:      organizationNaturalKeyImporter("credential", listCredentialIDs),
+		CustomizeDiff: customizeDiffCredentialSecrets("ssh_key_data"),
+		Schema: withCredentialSecretSchemas(map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -51,7 +52,7 @@ func resourceCredentialGoogleComputeEngine() *schema.Resource {
 				Sensitive:   true,
 				Description: "The SSH key data to use for the credential.",
 			},
-		},
+		}),
 	}
 }
 
@@ -60,21 +61,16 @@ func resourceCredentialGoogleComputeEngineCreate(ctx context.Context, d *schema.
 	var err error
 
 	client := m.(*awx.AWX)
-	gceCredType, err := client.CredentialTypeService.GetCredentialTypeByName(gceCredentialTypeName, map[string]string{})
+	credentialTypeID, err := resolveManagedCredentialType(client, gceCredentialType)
 	if err != nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to create new credentials",
-			Detail:   fmt.Sprintf("Unable to fetch credential type with Name: %s. Error: %s", gceCredentialTypeName, err.Error()),
-		})
-		return diags
+		return utils.DiagCreate(diagCredentialTitle, err)
 	}
 
 	newCredential := map[string]interface{}{
 		"name":            d.Get("name").(string),
 		"description":     d.Get("description").(string),
 		"organization":    d.Get("organization_id").(int),
-		"credential_type": gceCredType.ID,
+		"credential_type": credentialTypeID,
 		"inputs": map[string]interface{}{
 			"username":     d.Get("username").(string),
 			"project":      d.Get("project").(string),
@@ -93,9 +89,10 @@ func resourceCredentialGoogleComputeEngineCreate(ctx context.Context, d *schema.
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
-	resourceCredentialGoogleComputeEngineRead(ctx, d, m)
-
-	return diags
+	if err := recordCredentialSecrets(d, cred, newCredential, "ssh_key_data"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCredentialGoogleComputeEngineRead(ctx, d, m)
 }
 
 func resourceCredentialGoogleComputeEngineRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -129,7 +126,7 @@ func resourceCredentialGoogleComputeEngineRead(_ context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	return diags
+	return append(diags, checkCredentialModified(d, cred)...)
 }
 
 func resourceCredentialGoogleComputeEngineUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -141,6 +138,7 @@ func resourceCredentialGoogleComputeEngineUpdate(ctx context.Context, d *schema.
 		"username",
 		"project",
 		"ssh_key_data",
+		"secret_hashes",
 	}
 
 	client := m.(*awx.AWX)
@@ -148,14 +146,9 @@ func resourceCredentialGoogleComputeEngineUpdate(ctx context.Context, d *schema.
 	if d.HasChanges(keys...) {
 		var err error
 
-		gceCredType, err := client.CredentialTypeService.GetCredentialTypeByName(gceCredentialTypeName, map[string]string{})
+		credentialTypeID, err := resolveManagedCredentialType(client, gceCredentialType)
 		if err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Unable to create new credentials",
-				Detail:   fmt.Sprintf("Unable to fetch credential type with Name: %s. Error: %s", gceCredentialTypeName, err.Error()),
-			})
-			return diags
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
 		}
 
 		id, _ := strconv.Atoi(d.Id())
@@ -163,7 +156,7 @@ func resourceCredentialGoogleComputeEngineUpdate(ctx context.Context, d *schema.
 			"name":            d.Get("name").(string),
 			"description":     d.Get("description").(string),
 			"organization":    d.Get("organization_id").(int),
-			"credential_type": gceCredType.ID,
+			"credential_type": credentialTypeID,
 			"inputs": map[string]interface{}{
 				"username":     d.Get("username").(string),
 				"project":      d.Get("project").(string),
@@ -171,7 +164,11 @@ func resourceCredentialGoogleComputeEngineUpdate(ctx context.Context, d *schema.
 			},
 		}
 
-		_, err = client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
+		if err := keepCredentialSecrets(d, client, id, updatedCredential, "ssh_key_data"); err != nil {
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
+		}
+
+		updated, err := client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -180,6 +177,9 @@ func resourceCredentialGoogleComputeEngineUpdate(ctx context.Context, d *schema.
 			})
 			return diags
 		}
+		if err := recordCredentialSecrets(d, updated, updatedCredential, "ssh_key_data"); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceCredentialGoogleComputeEngineRead(ctx, d, m)
@@ -0,0 +1,260 @@
+package awx
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
+)
+
+const diagCredentialHashiCorpVaultSignedSSHTitle = "HashiCorp Vault Signed SSH Credential"
+
+//nolint:funlen
+func resourceCredentialHashiCorpVaultSignedSSH() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`awx_credential_hashicorp_vault_signed_ssh` manages HashiCorp Vault Signed SSH credentials in AWX, a source for `awx_credential_input_source`.",
+		CreateContext: resourceCredentialHashiCorpVaultSignedSSHCreate,
+		ReadContext:   resourceCredentialHashiCorpVaultSignedSSHRead,
+		UpdateContext: resourceCredentialHashiCorpVaultSignedSSHUpdate,
+		DeleteContext: resourceCredentialDelete,
+		Importer:      organizationNaturalKeyImporter("credential", listCredentialIDs),
+		CustomizeDiff: customizeDiffCredentialSecrets("token", "cacert", "secret_id", "client_cert_private"),
+		Schema: withCredentialSecretSchemas(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the credential.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the credential.",
+			},
+			"organization_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The organization ID this credential belongs to.",
+			},
+			"validate_on_create": validateOnCreateSchema(
+				"Check that AWX can look up the secret described by `validation_metadata` before creating the credential."),
+			"validation_metadata": validationMetadataSchema(),
+			"url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The URL of the HashiCorp Vault server.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The token used to authenticate to Vault.",
+			},
+			"cacert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded CA certificate used to verify the Vault server.",
+			},
+			"role_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The role ID, for AppRole authentication.",
+			},
+			"secret_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The secret ID, for AppRole authentication.",
+			},
+			"client_cert_public": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The PEM encoded client certificate, for TLS authentication.",
+			},
+			"client_cert_private": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded client certificate key, for TLS authentication.",
+			},
+			"client_cert_role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The role, for TLS authentication.",
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Vault namespace, for Vault Enterprise.",
+			},
+			"default_auth_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "approle",
+				Description: "The mount path of the AppRole or TLS authentication method.",
+			},
+		}),
+	}
+}
+
+func resourceCredentialHashiCorpVaultSignedSSHCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	credentialTypeID, err := resolveManagedCredentialType(client, hashiCorpVaultSignedSSHCredentialType)
+	if err != nil {
+		return utils.DiagCreate(diagCredentialHashiCorpVaultSignedSSHTitle, err)
+	}
+
+	payload := map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"description":     d.Get("description").(string),
+		"organization":    d.Get("organization_id").(int),
+		"credential_type": credentialTypeID,
+		"inputs": map[string]interface{}{
+			"url":                 d.Get("url").(string),
+			"token":               d.Get("token").(string),
+			"cacert":              d.Get("cacert").(string),
+			"role_id":             d.Get("role_id").(string),
+			"secret_id":           d.Get("secret_id").(string),
+			"client_cert_public":  d.Get("client_cert_public").(string),
+			"client_cert_private": d.Get("client_cert_private").(string),
+			"client_cert_role":    d.Get("client_cert_role").(string),
+			"namespace":           d.Get("namespace").(string),
+			"default_auth_path":   d.Get("default_auth_path").(string),
+		},
+	}
+
+	if err := validateLookupCredentialOnCreate(d, client, credentialTypeID, payload); err != nil {
+		return utils.DiagCreate(diagCredentialHashiCorpVaultSignedSSHTitle, err)
+	}
+
+	cred, err := client.CredentialsService.CreateCredentials(payload, map[string]string{})
+	if err != nil {
+		return utils.DiagCreate(diagCredentialHashiCorpVaultSignedSSHTitle, err)
+	}
+
+	d.SetId(strconv.Itoa(cred.ID))
+	if err := recordCredentialSecrets(d, cred, payload, "token", "cacert", "secret_id", "client_cert_private"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCredentialHashiCorpVaultSignedSSHRead(ctx, d, m)
+}
+
+func resourceCredentialHashiCorpVaultSignedSSHRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return utils.DiagFetch(diagCredentialHashiCorpVaultSignedSSHTitle, d.Id(), err)
+	}
+	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	if err != nil {
+		return utils.DiagFetch(diagCredentialHashiCorpVaultSignedSSHTitle, d.Id(), err)
+	}
+
+	if err := d.Set("name", cred.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", cred.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("organization_id", cred.OrganizationID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("url", cred.Inputs["url"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCredentialSecret(d, "token", cred.Inputs["token"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCredentialSecret(d, "cacert", cred.Inputs["cacert"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("role_id", cred.Inputs["role_id"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCredentialSecret(d, "secret_id", cred.Inputs["secret_id"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("client_cert_public", cred.Inputs["client_cert_public"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCredentialSecret(d, "client_cert_private", cred.Inputs["client_cert_private"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("client_cert_role", cred.Inputs["client_cert_role"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("namespace", cred.Inputs["namespace"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("default_auth_path", cred.Inputs["default_auth_path"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return checkCredentialModified(d, cred)
+}
+
+func resourceCredentialHashiCorpVaultSignedSSHUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	keys := []string{
+		"name",
+		"description",
+		"organization_id",
+		"url",
+		"token",
+		"cacert",
+		"role_id",
+		"secret_id",
+		"client_cert_public",
+		"client_cert_private",
+		"client_cert_role",
+		"namespace",
+		"default_auth_path",
+		"secret_hashes",
+	}
+
+	if d.HasChanges(keys...) {
+		id, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialHashiCorpVaultSignedSSHTitle, d.Id(), err)
+		}
+		client := m.(*awx.AWX)
+		credentialTypeID, err := resolveManagedCredentialType(client, hashiCorpVaultSignedSSHCredentialType)
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialHashiCorpVaultSignedSSHTitle, d.Id(), err)
+		}
+
+		payload := map[string]interface{}{
+			"name":            d.Get("name").(string),
+			"description":     d.Get("description").(string),
+			"organization":    d.Get("organization_id").(int),
+			"credential_type": credentialTypeID,
+			"inputs": map[string]interface{}{
+				"url":                 d.Get("url").(string),
+				"token":               d.Get("token").(string),
+				"cacert":              d.Get("cacert").(string),
+				"role_id":             d.Get("role_id").(string),
+				"secret_id":           d.Get("secret_id").(string),
+				"client_cert_public":  d.Get("client_cert_public").(string),
+				"client_cert_private": d.Get("client_cert_private").(string),
+				"client_cert_role":    d.Get("client_cert_role").(string),
+				"namespace":           d.Get("namespace").(string),
+				"default_auth_path":   d.Get("default_auth_path").(string),
+			},
+		}
+		if err := keepCredentialSecrets(d, client, id, payload, "token", "cacert", "secret_id", "client_cert_private"); err != nil {
+			return utils.DiagUpdate(diagCredentialHashiCorpVaultSignedSSHTitle, d.Id(), err)
+		}
+
+		updated, err := client.CredentialsService.UpdateCredentialsByID(id, payload, map[string]string{})
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialHashiCorpVaultSignedSSHTitle, d.Id(), err)
+		}
+		if err := recordCredentialSecrets(d, updated, payload, "token", "cacert", "secret_id", "client_cert_private"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCredentialHashiCorpVaultSignedSSHRead(ctx, d, m)
+}
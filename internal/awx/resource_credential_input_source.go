@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
 func resourceCredentialInputSource() *schema.Resource {
@@ -17,6 +18,10 @@ func resourceCredentialInputSource() *schema.Resource {
 		ReadContext:   resourceCredentialInputSourceRead,
 		UpdateContext: resourceCredentialInputSourceUpdate,
 		DeleteContext: resourceCredentialInputSourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: customizeDiffCredentialInputSourceMetadata,
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:        schema.TypeString,
@@ -44,8 +49,10 @@ func resourceCredentialInputSource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 				Optional:    true,
-				Description: "Metadata for the input source",
+				Description: "Metadata for the input source, validated against the metadata fields of the source credential type",
 			},
+			"validate_on_create": validateOnCreateSchema(
+				"Check that the source credential can look up the secret described by `metadata` before creating the input source."),
 		},
 	}
 }
@@ -63,6 +70,13 @@ func resourceCredentialInputSourceCreate(ctx context.Context, d *schema.Resource
 	}
 
 	client := m.(*awx.AWX)
+	if d.Get("validate_on_create").(bool) {
+		metadata := d.Get("metadata").(map[string]interface{})
+		if err := client.CredentialsService.TestCredential(d.Get("source").(int), metadata, map[string]string{}); err != nil {
+			return utils.DiagCreate("Credential Input Source", fmt.Errorf("the source credential failed to look up a secret with %v, got %w", metadata, err))
+		}
+	}
+
 	cred, err := client.CredentialInputSourceService.CreateCredentialInputSource(newSourceInput, map[string]string{})
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
@@ -167,3 +181,28 @@ func resourceCredentialInputSourceDelete(_ context.Context, d *schema.ResourceDa
 
 	return diags
 }
+
+// customizeDiffCredentialInputSourceMetadata validates the planned metadata against the
+// metadata fields of the type of the source credential, when they are known and changed.
+func customizeDiffCredentialInputSourceMetadata(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	keys := []string{"source", "metadata"}
+	for _, k := range keys {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+	if d.Id() != "" && !d.HasChanges(keys...) {
+		return nil
+	}
+
+	client := m.(*awx.AWX)
+	source, err := client.CredentialsService.GetCredentialsByID(d.Get("source").(int), map[string]string{})
+	if err != nil {
+		return fmt.Errorf("unable to fetch source credential %d, got %w", d.Get("source").(int), err)
+	}
+	ct, err := client.CredentialTypeService.GetCredentialTypeByID(source.CredentialTypeID, map[string]string{})
+	if err != nil {
+		return fmt.Errorf("unable to fetch credential type %d, got %w", source.CredentialTypeID, err)
+	}
+	return checkCredentialTypeMetadata(ct, d.Get("metadata").(map[string]interface{}))
+}
@@ -0,0 +1,215 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+// credentialInputsSchema returns the schema of a JSON object of credential inputs, so that
+// values keep their type and whitespace where a map of strings would not.
+func credentialInputsSchema(description string, sensitive bool) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        sensitive,
+		Description:      description,
+		ValidateFunc:     validateCredentialInputs,
+		DiffSuppressFunc: suppressEquivalentCredentialInputs,
+	}
+}
+
+func validateCredentialInputs(v interface{}, k string) ([]string, []error) {
+	if _, err := decodeCredentialInputs(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s must be a JSON object, got %w", k, err)}
+	}
+	return nil, nil
+}
+
+func suppressEquivalentCredentialInputs(_, o, n string, _ *schema.ResourceData) bool {
+	a, err := decodeCredentialInputs(o)
+	if err != nil {
+		return false
+	}
+	b, err := decodeCredentialInputs(n)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// decodeCredentialInputs decodes a JSON object of credential inputs, empty when s is.
+func decodeCredentialInputs(s string) (map[string]interface{}, error) {
+	inputs := make(map[string]interface{})
+	if s == "" {
+		return inputs, nil
+	}
+	if err := json.Unmarshal([]byte(s), &inputs); err != nil {
+		return nil, err
+	}
+	if inputs == nil {
+		return nil, errors.New("null is not an object")
+	}
+	return inputs, nil
+}
+
+// encodeCredentialInputs encodes credential inputs as a JSON object, leaving out empty ones
+// so that an unset attribute stays unset.
+func encodeCredentialInputs(inputs map[string]interface{}) (string, error) {
+	if len(inputs) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(inputs)
+	return string(b), err
+}
+
+// mergeCredentialInputs returns the inputs sent to AWX, the union of `inputs` and `sensitive_inputs`.
+func mergeCredentialInputs(inputs, sensitiveInputs string) (map[string]interface{}, error) {
+	merged, err := decodeCredentialInputs(inputs)
+	if err != nil {
+		return nil, fmt.Errorf("inputs must be a JSON object, got %w", err)
+	}
+	sensitive, err := decodeCredentialInputs(sensitiveInputs)
+	if err != nil {
+		return nil, fmt.Errorf("sensitive_inputs must be a JSON object, got %w", err)
+	}
+	for k, v := range sensitive {
+		if _, ok := merged[k]; ok {
+			return nil, fmt.Errorf("input %s is set in both inputs and sensitive_inputs", k)
+		}
+		merged[k] = v
+	}
+	return merged, nil
+}
+
+// credentialTypeInputs decodes the inputs schema of a credential type.
+func credentialTypeInputs(ct *awx.CredentialType) (*awx.CredentialTypeInputs, error) {
+	b, err := json.Marshal(ct.Inputs)
+	if err != nil {
+		return nil, err
+	}
+	inputs := new(awx.CredentialTypeInputs)
+	if err := json.Unmarshal(b, inputs); err != nil {
+		return nil, err
+	}
+	return inputs, nil
+}
+
+// checkCredentialTypeInputs validates credential inputs against the fields of their
+// credential type: unknown inputs, value types, choices and required fields.
+func checkCredentialTypeInputs(ct *awx.CredentialType, inputs map[string]interface{}) error {
+	typeInputs, err := credentialTypeInputs(ct)
+	if err != nil {
+		return fmt.Errorf("unable to read the inputs of credential type %s, got %w", ct.Name, err)
+	}
+	return checkCredentialTypeFields(ct, "input", typeInputs.Fields, typeInputs.Required, inputs)
+}
+
+// checkCredentialTypeMetadata validates the metadata of a credential input source against the
+// metadata fields of the source credential type. Metadata are strings in Terraform, so the
+// booleans are given as `true` or `false`.
+func checkCredentialTypeMetadata(ct *awx.CredentialType, metadata map[string]interface{}) error {
+	typeInputs, err := credentialTypeInputs(ct)
+	if err != nil {
+		return fmt.Errorf("unable to read the inputs of credential type %s, got %w", ct.Name, err)
+	}
+
+	values := make(map[string]interface{}, len(metadata))
+	for k, v := range metadata {
+		values[k] = v
+		for _, f := range typeInputs.Metadata {
+			if b, err := strconv.ParseBool(v.(string)); f.ID == k && f.Type == "boolean" && err == nil {
+				values[k] = b
+			}
+		}
+	}
+	return checkCredentialTypeFields(ct, "metadata", typeInputs.Metadata, typeInputs.Required, values)
+}
+
+// checkCredentialTypeFields validates values against fields of a credential type: unknown
+// names, value types, choices and required fields. The required names of the credential type
+// cover both its inputs and metadata, only those of fields are checked.
+func checkCredentialTypeFields(ct *awx.CredentialType, kind string, typeFields []*awx.CredentialTypeField,
+	required []string, values map[string]interface{}) error {
+	fields := make(map[string]*awx.CredentialTypeField, len(typeFields))
+	for _, f := range typeFields {
+		fields[f.ID] = f
+	}
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var errs []error
+	for _, k := range keys {
+		f, ok := fields[k]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s %s is not a field of credential type %s", kind, k, ct.Name))
+			continue
+		}
+		switch v := values[k].(type) {
+		case bool:
+			if f.Type != "boolean" {
+				errs = append(errs, fmt.Errorf("%s %s of credential type %s must be a %s, got a boolean", kind, k, ct.Name, f.Type))
+			}
+		case string:
+			if f.Type == "boolean" {
+				errs = append(errs, fmt.Errorf("%s %s of credential type %s must be a boolean, got a string", kind, k, ct.Name))
+			} else if len(f.Choices) > 0 && !slices.Contains(f.Choices, v) {
+				errs = append(errs, fmt.Errorf("%s %s of credential type %s must be one of %v, got %q", kind, k, ct.Name, f.Choices, v))
+			}
+		case float64:
+			// AWX fields are strings or booleans, numbers such as ports are not coerced.
+			if f.Type == "string" {
+				errs = append(errs, fmt.Errorf("%s %s of credential type %s must be given as a string, got the number %v", kind, k, ct.Name, v))
+			} else {
+				errs = append(errs, fmt.Errorf("%s %s of credential type %s must be a %s, got the number %v", kind, k, ct.Name, f.Type, v))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("%s %s of credential type %s must be a %s, got %v", kind, k, ct.Name, f.Type, v))
+		}
+	}
+	for _, k := range required {
+		if f, ok := fields[k]; ok && f.Default == nil {
+			if _, ok := values[k]; !ok {
+				errs = append(errs, fmt.Errorf("%s %s is required by credential type %s", kind, k, ct.Name))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// customizeDiffCredentialInputs validates the planned inputs of `awx_credential` against
+// its credential type, when they are known and changed.
+func customizeDiffCredentialInputs(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	keys := []string{"credential_type_id", "inputs", "sensitive_inputs"}
+	for _, k := range keys {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+	if d.Id() != "" && !d.HasChanges(keys...) {
+		return nil
+	}
+
+	inputs, err := mergeCredentialInputs(d.Get("inputs").(string), d.Get("sensitive_inputs").(string))
+	if err != nil {
+		return err
+	}
+	client := m.(*awx.AWX)
+	ct, err := client.CredentialTypeService.GetCredentialTypeByID(d.Get("credential_type_id").(int), map[string]string{})
+	if err != nil {
+		return fmt.Errorf("unable to fetch credential type %d, got %w", d.Get("credential_type_id").(int), err)
+	}
+	return checkCredentialTypeInputs(ct, inputs)
+}
@@ -0,0 +1,188 @@
+package awx
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+)
+
+// testLookupCredentialType is a secret lookup credential type, with a token required by its
+// inputs only and a secret path required by its metadata.
+func testLookupCredentialType() *awx.CredentialType {
+	return &awx.CredentialType{
+		Name: "Lookup",
+		Inputs: map[string]interface{}{
+			"fields": []interface{}{
+				map[string]interface{}{"id": "url", "type": "string"},
+				map[string]interface{}{"id": "token", "type": "string", "secret": true},
+				map[string]interface{}{"id": "api_version", "type": "string", "choices": []interface{}{"v1", "v2"}, "default": "v1"},
+				map[string]interface{}{"id": "verify_ssl", "type": "boolean"},
+			},
+			"metadata": []interface{}{
+				map[string]interface{}{"id": "secret_path", "type": "string"},
+				map[string]interface{}{"id": "secret_backend", "type": "string", "choices": []interface{}{"kv", "ssh"}},
+				map[string]interface{}{"id": "lookup_latest", "type": "boolean"},
+			},
+			"required": []interface{}{"url", "token", "api_version", "secret_path"},
+		},
+	}
+}
+
+func TestCheckCredentialTypeInputs(t *testing.T) {
+	cases := []struct {
+		name   string
+		inputs map[string]interface{}
+		errs   []string
+	}{
+		{
+			name:   "valid",
+			inputs: map[string]interface{}{"url": "https://vault", "token": "s3cret", "verify_ssl": false},
+		},
+		{
+			name:   "unknown field",
+			inputs: map[string]interface{}{"url": "https://vault", "token": "s3cret", "secret_path": "kv/app"},
+			errs:   []string{"input secret_path is not a field of credential type Lookup"},
+		},
+		{
+			name:   "bad choice",
+			inputs: map[string]interface{}{"url": "https://vault", "token": "s3cret", "api_version": "v3"},
+			errs:   []string{`input api_version of credential type Lookup must be one of [v1 v2], got "v3"`},
+		},
+		{
+			name:   "missing required field",
+			inputs: map[string]interface{}{"url": "https://vault"},
+			errs:   []string{"input token is required by credential type Lookup"},
+		},
+		{
+			name:   "boolean given as a string",
+			inputs: map[string]interface{}{"url": "https://vault", "token": "s3cret", "verify_ssl": "false"},
+			errs:   []string{"input verify_ssl of credential type Lookup must be a boolean, got a string"},
+		},
+		{
+			name:   "string given as a boolean",
+			inputs: map[string]interface{}{"url": true, "token": "s3cret"},
+			errs:   []string{"input url of credential type Lookup must be a string, got a boolean"},
+		},
+		{
+			name:   "number given for a string",
+			inputs: map[string]interface{}{"url": "https://vault", "token": "s3cret", "api_version": float64(2)},
+			errs:   []string{"input api_version of credential type Lookup must be given as a string, got the number 2"},
+		},
+		{
+			name:   "number given for a boolean",
+			inputs: map[string]interface{}{"url": "https://vault", "token": "s3cret", "verify_ssl": float64(1)},
+			errs:   []string{"input verify_ssl of credential type Lookup must be a boolean, got the number 1"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkErrors(t, checkCredentialTypeInputs(testLookupCredentialType(), tc.inputs), tc.errs)
+		})
+	}
+}
+
+func TestCheckCredentialTypeMetadata(t *testing.T) {
+	cases := []struct {
+		name     string
+		metadata map[string]interface{}
+		errs     []string
+	}{
+		{
+			name:     "valid",
+			metadata: map[string]interface{}{"secret_path": "kv/app", "secret_backend": "kv"},
+		},
+		{
+			name:     "true given as a string",
+			metadata: map[string]interface{}{"secret_path": "kv/app", "lookup_latest": "true"},
+		},
+		{
+			name:     "false given as a string",
+			metadata: map[string]interface{}{"secret_path": "kv/app", "lookup_latest": "false"},
+		},
+		{
+			name:     "not a boolean",
+			metadata: map[string]interface{}{"secret_path": "kv/app", "lookup_latest": "yes please"},
+			errs:     []string{"metadata lookup_latest of credential type Lookup must be a boolean, got a string"},
+		},
+		{
+			name:     "unknown field",
+			metadata: map[string]interface{}{"secret_path": "kv/app", "url": "https://vault"},
+			errs:     []string{"metadata url is not a field of credential type Lookup"},
+		},
+		{
+			name:     "bad choice",
+			metadata: map[string]interface{}{"secret_path": "kv/app", "secret_backend": "pki"},
+			errs:     []string{`metadata secret_backend of credential type Lookup must be one of [kv ssh], got "pki"`},
+		},
+		{
+			// url and token are required inputs, not metadata.
+			name:     "missing required field",
+			metadata: map[string]interface{}{"secret_backend": "kv"},
+			errs:     []string{"metadata secret_path is required by credential type Lookup"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkErrors(t, checkCredentialTypeMetadata(testLookupCredentialType(), tc.metadata), tc.errs)
+		})
+	}
+}
+
+func TestCustomizeDiffCredentialInputSourceMetadata(t *testing.T) {
+	f := awxtest.NewFakeAWX(1)
+	ct := f.Add("credential_types", map[string]interface{}{"name": "Lookup", "inputs": testLookupCredentialType().Inputs})
+	source := f.Add("credentials", map[string]interface{}{"name": "vault", "credential_type": ct})
+	// An unknown source is not looked up, the server then fails on any request.
+	offline := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	cases := []struct {
+		name    string
+		handler http.Handler
+		source  cty.Value
+		err     string
+	}{
+		{name: "valid metadata", handler: f, source: cty.NumberIntVal(int64(source))},
+		{name: "invalid metadata", handler: f, source: cty.NumberIntVal(int64(source)), err: "metadata secret_backend of credential type Lookup must be one of [kv ssh]"},
+		{name: "source unknown at plan time", handler: offline, source: cty.UnknownVal(cty.Number)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := "kv"
+			if tc.err != "" {
+				backend = "pki"
+			}
+			r := resourceCredentialInputSource()
+			coreSchema := r.CoreConfigSchema()
+			config, err := coreSchema.CoerceValue(cty.ObjectVal(map[string]cty.Value{
+				"input_field_name": cty.StringVal("password"),
+				"target":           cty.NumberIntVal(99),
+				"source":           tc.source,
+				"metadata": cty.MapVal(map[string]cty.Value{
+					"secret_path":    cty.StringVal("kv/app"),
+					"secret_backend": cty.StringVal(backend),
+				}),
+			}))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := awxtest.NewClient(t, tc.handler)
+			_, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigShimmed(config, coreSchema), client)
+			if tc.err == "" && err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+			if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+				t.Fatalf("Expecting an error containing %q but got %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package awx
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
+)
+
+const diagCredentialKubernetesBearerTokenTitle = "Kubernetes Bearer Token Credential"
+
+func resourceCredentialKubernetesBearerToken() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`awx_credential_kubernetes_bearer_token` manages OpenShift or Kubernetes API bearer token credentials in AWX, as used by container groups.",
+		CreateContext: resourceCredentialKubernetesBearerTokenCreate,
+		ReadContext:   resourceCredentialKubernetesBearerTokenRead,
+		UpdateContext: resourceCredentialKubernetesBearerTokenUpdate,
+		DeleteContext: resourceCredentialDelete,
+		Importer:      organizationNaturalKeyImporter("credential", listCredentialIDs),
+		CustomizeDiff: customizeDiffCredentialSecrets("bearer_token", "ssl_ca_cert"),
+		Schema: withCredentialSecretSchemas(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the credential.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the credential.",
+			},
+			"organization_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The organization ID this credential belongs to.",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The OpenShift or Kubernetes API endpoint.",
+			},
+			"bearer_token": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The API authentication bearer token.",
+			},
+			"verify_ssl": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Verify the SSL certificate of the API endpoint.",
+			},
+			"ssl_ca_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded certificate authority data used to verify the API endpoint.",
+			},
+		}),
+	}
+}
+
+func resourceCredentialKubernetesBearerTokenCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	credentialTypeID, err := resolveManagedCredentialType(client, kubernetesBearerTokenCredentialType)
+	if err != nil {
+		return utils.DiagCreate(diagCredentialKubernetesBearerTokenTitle, err)
+	}
+
+	payload := map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"description":     d.Get("description").(string),
+		"organization":    d.Get("organization_id").(int),
+		"credential_type": credentialTypeID,
+		"inputs": map[string]interface{}{
+			"host":         d.Get("host").(string),
+			"bearer_token": d.Get("bearer_token").(string),
+			"verify_ssl":   d.Get("verify_ssl").(bool),
+			"ssl_ca_cert":  d.Get("ssl_ca_cert").(string),
+		},
+	}
+
+	cred, err := client.CredentialsService.CreateCredentials(payload, map[string]string{})
+	if err != nil {
+		return utils.DiagCreate(diagCredentialKubernetesBearerTokenTitle, err)
+	}
+
+	d.SetId(strconv.Itoa(cred.ID))
+	if err := recordCredentialSecrets(d, cred, payload, "bearer_token", "ssl_ca_cert"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCredentialKubernetesBearerTokenRead(ctx, d, m)
+}
+
+func resourceCredentialKubernetesBearerTokenRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return utils.DiagFetch(diagCredentialKubernetesBearerTokenTitle, d.Id(), err)
+	}
+	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	if err != nil {
+		return utils.DiagFetch(diagCredentialKubernetesBearerTokenTitle, d.Id(), err)
+	}
+
+	if err := d.Set("name", cred.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", cred.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("organization_id", cred.OrganizationID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("host", cred.Inputs["host"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCredentialSecret(d, "bearer_token", cred.Inputs["bearer_token"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("verify_ssl", cred.Inputs["verify_ssl"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCredentialSecret(d, "ssl_ca_cert", cred.Inputs["ssl_ca_cert"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return checkCredentialModified(d, cred)
+}
+
+func resourceCredentialKubernetesBearerTokenUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	keys := []string{
+		"name",
+		"description",
+		"organization_id",
+		"host",
+		"bearer_token",
+		"verify_ssl",
+		"ssl_ca_cert",
+		"secret_hashes",
+	}
+
+	if d.HasChanges(keys...) {
+		id, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialKubernetesBearerTokenTitle, d.Id(), err)
+		}
+		client := m.(*awx.AWX)
+		credentialTypeID, err := resolveManagedCredentialType(client, kubernetesBearerTokenCredentialType)
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialKubernetesBearerTokenTitle, d.Id(), err)
+		}
+
+		payload := map[string]interface{}{
+			"name":            d.Get("name").(string),
+			"description":     d.Get("description").(string),
+			"organization":    d.Get("organization_id").(int),
+			"credential_type": credentialTypeID,
+			"inputs": map[string]interface{}{
+				"host":         d.Get("host").(string),
+				"bearer_token": d.Get("bearer_token").(string),
+				"verify_ssl":   d.Get("verify_ssl").(bool),
+				"ssl_ca_cert":  d.Get("ssl_ca_cert").(string),
+			},
+		}
+		if err := keepCredentialSecrets(d, client, id, payload, "bearer_token", "ssl_ca_cert"); err != nil {
+			return utils.DiagUpdate(diagCredentialKubernetesBearerTokenTitle, d.Id(), err)
+		}
+
+		updated, err := client.CredentialsService.UpdateCredentialsByID(id, payload, map[string]string{})
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialKubernetesBearerTokenTitle, d.Id(), err)
+		}
+		if err := recordCredentialSecrets(d, updated, payload, "bearer_token", "ssl_ca_cert"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCredentialKubernetesBearerTokenRead(ctx, d, m)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
 //nolint:funlen
@@ -18,7 +19,9 @@ func resourceCredentialMachine() *schema.Resource {
 		ReadContext:   resourceCredentialMachineRead,
 		UpdateContext: resourceCredentialMachineUpdate,
 		DeleteContext: resourceCredentialDelete,
-		Schema: map[string]*schema.Schema{
+		Importer:      organizationNaturalKeyImporter("credential", listCredentialIDs),
+		CustomizeDiff: customizeDiffCredentialSecrets("password", "ssh_key_data", "ssh_key_unlock", "become_password"),
+		Schema: withCredentialSecretSchemas(map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -78,7 +81,7 @@ func resourceCredentialMachine() *schema.Resource {
 				Sensitive:   true,
 				Description: "The become password for the credential.",
 			},
-		},
+		}),
 	}
 }
 
@@ -86,11 +89,16 @@ func resourceCredentialMachineCreate(ctx context.Context, d *schema.ResourceData
 	var diags diag.Diagnostics
 	var err error
 
+	credentialTypeID, err := resolveManagedCredentialType(m.(*awx.AWX), machineCredentialType)
+	if err != nil {
+		return utils.DiagCreate(diagCredentialTitle, err)
+	}
+
 	newCredential := map[string]interface{}{
 		"name":            d.Get("name").(string),
 		"description":     d.Get("description").(string),
 		"organization":    d.Get("organization_id").(int),
-		"credential_type": 1, // SSH
+		"credential_type": credentialTypeID,
 		"inputs": map[string]interface{}{
 			"username":            d.Get("username").(string),
 			"password":            d.Get("password").(string),
@@ -115,9 +123,10 @@ func resourceCredentialMachineCreate(ctx context.Context, d *schema.ResourceData
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
-	resourceCredentialMachineRead(ctx, d, m)
-
-	return diags
+	if err := recordCredentialSecrets(d, cred, newCredential, "password", "ssh_key_data", "ssh_key_unlock", "become_password"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCredentialMachineRead(ctx, d, m)
 }
 
 func resourceCredentialMachineRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -144,16 +153,16 @@ func resourceCredentialMachineRead(_ context.Context, d *schema.ResourceData, m
 	if err := d.Set("username", cred.Inputs["username"]); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("password", cred.Inputs["password"]); err != nil {
+	if err := setCredentialSecret(d, "password", cred.Inputs["password"]); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("ssh_key_data", cred.Inputs["ssh_key_data"]); err != nil {
+	if err := setCredentialSecret(d, "ssh_key_data", cred.Inputs["ssh_key_data"]); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("ssh_public_key_data", cred.Inputs["ssh_public_key_data"]); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("ssh_key_unlock", cred.Inputs["ssh_key_unlock"]); err != nil {
+	if err := setCredentialSecret(d, "ssh_key_unlock", cred.Inputs["ssh_key_unlock"]); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("become_method", cred.Inputs["become_method"]); err != nil {
@@ -162,14 +171,14 @@ func resourceCredentialMachineRead(_ context.Context, d *schema.ResourceData, m
 	if err := d.Set("become_username", cred.Inputs["become_username"]); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("become_password", cred.Inputs["become_password"]); err != nil {
+	if err := setCredentialSecret(d, "become_password", cred.Inputs["become_password"]); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("organization_id", cred.OrganizationID); err != nil {
 		return diag.FromErr(err)
 	}
 
-	return diags
+	return append(diags, checkCredentialModified(d, cred)...)
 }
 
 func resourceCredentialMachineUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -189,17 +198,23 @@ func resourceCredentialMachineUpdate(ctx context.Context, d *schema.ResourceData
 		"organization_id",
 		"team_id",
 		"owner_id",
+		"secret_hashes",
 	}
 
 	if d.HasChanges(keys...) {
 		var err error
 
 		id, _ := strconv.Atoi(d.Id())
+		credentialTypeID, err := resolveManagedCredentialType(m.(*awx.AWX), machineCredentialType)
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
+		}
+
 		updatedCredential := map[string]interface{}{
 			"name":            d.Get("name").(string),
 			"description":     d.Get("description").(string),
 			"organization":    d.Get("organization_id").(int),
-			"credential_type": 1, // SSH
+			"credential_type": credentialTypeID,
 			"inputs": map[string]interface{}{
 				"username":            d.Get("username").(string),
 				"password":            d.Get("password").(string),
@@ -213,7 +228,11 @@ func resourceCredentialMachineUpdate(ctx context.Context, d *schema.ResourceData
 		}
 
 		client := m.(*awx.AWX)
-		_, err = client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
+		if err := keepCredentialSecrets(d, client, id, updatedCredential, "password", "ssh_key_data", "ssh_key_unlock", "become_password"); err != nil {
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
+		}
+
+		updated, err := client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -222,6 +241,9 @@ func resourceCredentialMachineUpdate(ctx context.Context, d *schema.ResourceData
 			})
 			return diags
 		}
+		if err := recordCredentialSecrets(d, updated, updatedCredential, "password", "ssh_key_data", "ssh_key_unlock", "become_password"); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceCredentialMachineRead(ctx, d, m)
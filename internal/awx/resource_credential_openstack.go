@@ -0,0 +1,228 @@
+package awx
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
+)
+
+const diagCredentialOpenStackTitle = "OpenStack Credential"
+
+//nolint:funlen
+func resourceCredentialOpenStack() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`awx_credential_openstack` manages OpenStack credentials in AWX.",
+		CreateContext: resourceCredentialOpenStackCreate,
+		ReadContext:   resourceCredentialOpenStackRead,
+		UpdateContext: resourceCredentialOpenStackUpdate,
+		DeleteContext: resourceCredentialDelete,
+		Importer:      organizationNaturalKeyImporter("credential", listCredentialIDs),
+		CustomizeDiff: customizeDiffCredentialSecrets("password"),
+		Schema: withCredentialSecretSchemas(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the credential.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the credential.",
+			},
+			"organization_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The organization ID this credential belongs to.",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The authentication URL of the OpenStack host.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The username of the credential.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password or API key of the credential.",
+			},
+			"project": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The project name or ID of the credential.",
+			},
+			"project_domain_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The domain name of the project.",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The domain name of the user, for Keystone v3.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The region of the OpenStack host.",
+			},
+			"verify_ssl": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Verify the SSL certificate of the OpenStack host.",
+			},
+		}),
+	}
+}
+
+func resourceCredentialOpenStackCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	credentialTypeID, err := resolveManagedCredentialType(client, openstackCredentialType)
+	if err != nil {
+		return utils.DiagCreate(diagCredentialOpenStackTitle, err)
+	}
+
+	payload := map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"description":     d.Get("description").(string),
+		"organization":    d.Get("organization_id").(int),
+		"credential_type": credentialTypeID,
+		"inputs": map[string]interface{}{
+			"host":                d.Get("host").(string),
+			"username":            d.Get("username").(string),
+			"password":            d.Get("password").(string),
+			"project":             d.Get("project").(string),
+			"project_domain_name": d.Get("project_domain_name").(string),
+			"domain":              d.Get("domain").(string),
+			"region":              d.Get("region").(string),
+			"verify_ssl":          d.Get("verify_ssl").(bool),
+		},
+	}
+
+	cred, err := client.CredentialsService.CreateCredentials(payload, map[string]string{})
+	if err != nil {
+		return utils.DiagCreate(diagCredentialOpenStackTitle, err)
+	}
+
+	d.SetId(strconv.Itoa(cred.ID))
+	if err := recordCredentialSecrets(d, cred, payload, "password"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCredentialOpenStackRead(ctx, d, m)
+}
+
+func resourceCredentialOpenStackRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return utils.DiagFetch(diagCredentialOpenStackTitle, d.Id(), err)
+	}
+	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	if err != nil {
+		return utils.DiagFetch(diagCredentialOpenStackTitle, d.Id(), err)
+	}
+
+	if err := d.Set("name", cred.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", cred.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("organization_id", cred.OrganizationID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("host", cred.Inputs["host"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("username", cred.Inputs["username"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCredentialSecret(d, "password", cred.Inputs["password"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("project", cred.Inputs["project"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("project_domain_name", cred.Inputs["project_domain_name"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("domain", cred.Inputs["domain"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("region", cred.Inputs["region"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("verify_ssl", cred.Inputs["verify_ssl"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return checkCredentialModified(d, cred)
+}
+
+func resourceCredentialOpenStackUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	keys := []string{
+		"name",
+		"description",
+		"organization_id",
+		"host",
+		"username",
+		"password",
+		"project",
+		"project_domain_name",
+		"domain",
+		"region",
+		"verify_ssl",
+		"secret_hashes",
+	}
+
+	if d.HasChanges(keys...) {
+		id, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialOpenStackTitle, d.Id(), err)
+		}
+		client := m.(*awx.AWX)
+		credentialTypeID, err := resolveManagedCredentialType(client, openstackCredentialType)
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialOpenStackTitle, d.Id(), err)
+		}
+
+		payload := map[string]interface{}{
+			"name":            d.Get("name").(string),
+			"description":     d.Get("description").(string),
+			"organization":    d.Get("organization_id").(int),
+			"credential_type": credentialTypeID,
+			"inputs": map[string]interface{}{
+				"host":                d.Get("host").(string),
+				"username":            d.Get("username").(string),
+				"password":            d.Get("password").(string),
+				"project":             d.Get("project").(string),
+				"project_domain_name": d.Get("project_domain_name").(string),
+				"domain":              d.Get("domain").(string),
+				"region":              d.Get("region").(string),
+				"verify_ssl":          d.Get("verify_ssl").(bool),
+			},
+		}
+		if err := keepCredentialSecrets(d, client, id, payload, "password"); err != nil {
+			return utils.DiagUpdate(diagCredentialOpenStackTitle, d.Id(), err)
+		}
+
+		updated, err := client.CredentialsService.UpdateCredentialsByID(id, payload, map[string]string{})
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialOpenStackTitle, d.Id(), err)
+		}
+		if err := recordCredentialSecrets(d, updated, payload, "password"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCredentialOpenStackRead(ctx, d, m)
+}
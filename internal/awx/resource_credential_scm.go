@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
 func resourceCredentialSCM() *schema.Resource {
@@ -17,7 +18,9 @@ func resourceCredentialSCM() *schema.Resource {
 		ReadContext:   resourceCredentialSCMRead,
 		UpdateContext: resourceCredentialSCMUpdate,
 		DeleteContext: resourceCredentialDelete,
-		Schema: map[string]*schema.Schema{
+		Importer:      organizationNaturalKeyImporter("credential", listCredentialIDs),
+		CustomizeDiff: customizeDiffCredentialSecrets("password", "ssh_key_data", "ssh_key_unlock"),
+		Schema: withCredentialSecretSchemas(map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -56,7 +59,7 @@ func resourceCredentialSCM() *schema.Resource {
 				Sensitive:   true,
 				Description: "The SSH key unlock for the credential.",
 			},
-		},
+		}),
 	}
 }
 
@@ -64,11 +67,16 @@ func resourceCredentialSCMCreate(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	var err error
 
+	credentialTypeID, err := resolveManagedCredentialType(m.(*awx.AWX), scmCredentialType)
+	if err != nil {
+		return utils.DiagCreate(diagCredentialTitle, err)
+	}
+
 	newCredential := map[string]interface{}{
 		"name":            d.Get("name").(string),
 		"description":     d.Get("description").(string),
 		"organization":    d.Get("organization_id").(int),
-		"credential_type": 2, // Source Controll
+		"credential_type": credentialTypeID,
 		"inputs": map[string]interface{}{
 			"username":       d.Get("username").(string),
 			"password":       d.Get("password").(string),
@@ -89,9 +97,10 @@ func resourceCredentialSCMCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
-	resourceCredentialSCMRead(ctx, d, m)
-
-	return diags
+	if err := recordCredentialSecrets(d, cred, newCredential, "password", "ssh_key_data", "ssh_key_unlock"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCredentialSCMRead(ctx, d, m)
 }
 
 func resourceCredentialSCMRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -118,20 +127,20 @@ func resourceCredentialSCMRead(_ context.Context, d *schema.ResourceData, m inte
 	if err := d.Set("username", cred.Inputs["username"]); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("password", cred.Inputs["password"]); err != nil {
+	if err := setCredentialSecret(d, "password", cred.Inputs["password"]); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("ssh_key_data", cred.Inputs["ssh_key_data"]); err != nil {
+	if err := setCredentialSecret(d, "ssh_key_data", cred.Inputs["ssh_key_data"]); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("ssh_key_unlock", cred.Inputs["ssh_key_unlock"]); err != nil {
+	if err := setCredentialSecret(d, "ssh_key_unlock", cred.Inputs["ssh_key_unlock"]); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("organization_id", cred.OrganizationID); err != nil {
 		return diag.FromErr(err)
 	}
 
-	return diags
+	return append(diags, checkCredentialModified(d, cred)...)
 }
 
 func resourceCredentialSCMUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -145,17 +154,23 @@ func resourceCredentialSCMUpdate(ctx context.Context, d *schema.ResourceData, m
 		"ssh_key_data",
 		"ssh_key_unlock",
 		"organization_id",
+		"secret_hashes",
 	}
 
 	if d.HasChanges(keys...) {
 		var err error
 
 		id, _ := strconv.Atoi(d.Id())
+		credentialTypeID, err := resolveManagedCredentialType(m.(*awx.AWX), scmCredentialType)
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
+		}
+
 		updatedCredential := map[string]interface{}{
 			"name":            d.Get("name").(string),
 			"description":     d.Get("description").(string),
 			"organization":    d.Get("organization_id").(int),
-			"credential_type": 2, // Source Controll
+			"credential_type": credentialTypeID,
 			"inputs": map[string]interface{}{
 				"username":       d.Get("username").(string),
 				"password":       d.Get("password").(string),
@@ -165,7 +180,11 @@ func resourceCredentialSCMUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 
 		client := m.(*awx.AWX)
-		_, err = client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
+		if err := keepCredentialSecrets(d, client, id, updatedCredential, "password", "ssh_key_data", "ssh_key_unlock"); err != nil {
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
+		}
+
+		updated, err := client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -174,6 +193,9 @@ func resourceCredentialSCMUpdate(ctx context.Context, d *schema.ResourceData, m
 			})
 			return diags
 		}
+		if err := recordCredentialSecrets(d, updated, updatedCredential, "password", "ssh_key_data", "ssh_key_unlock"); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceCredentialSCMRead(ctx, d, m)
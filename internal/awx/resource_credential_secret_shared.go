@@ -0,0 +1,191 @@
+package awx
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+// credentialSecretSchemas returns the computed attributes tracking the secrets of a credential,
+// which AWX never returns: a salted hash of every secret last sent by Terraform and the
+// modification time of the credential after that write. The hashes are an attribute rather
+// than private state, which the plugin SDK does not expose to resources, so they show as
+// known after apply in plans which send a secret.
+func credentialSecretSchemas() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"secret_hashes": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Salted SHA-256 hashes of the secret inputs last sent to AWX, an empty hash when a secret must be sent again.",
+		},
+		"modified": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the credential was last modified in AWX.",
+		},
+	}
+}
+
+// withCredentialSecretSchemas adds the attributes tracking the secrets to a credential schema.
+func withCredentialSecretSchemas(s map[string]*schema.Schema) map[string]*schema.Schema {
+	for k, v := range credentialSecretSchemas() {
+		s[k] = v
+	}
+	return s
+}
+
+// hashCredentialSecret returns `<salt>:<digest>`, the hex encoded SHA-256 digest of the
+// salt followed by the secret.
+func hashCredentialSecret(salt, value string) string {
+	sum := sha256.Sum256([]byte(salt + value))
+	return salt + ":" + hex.EncodeToString(sum[:])
+}
+
+// newCredentialSecretHash hashes the secret with a new random salt.
+func newCredentialSecretHash(value string) (string, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	return hashCredentialSecret(hex.EncodeToString(salt), value), nil
+}
+
+// credentialSecretMatches reports whether value is the secret hashed into hash.
+func credentialSecretMatches(hash interface{}, value string) bool {
+	h, _ := hash.(string)
+	salt, _, ok := strings.Cut(h, ":")
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(hashCredentialSecret(salt, value)), []byte(h)) == 1
+}
+
+// credentialSecretKeys returns the inputs AWX holds a secret for.
+func credentialSecretKeys(cred *awx.Credential) []string {
+	var keys []string
+	for k, v := range cred.Inputs {
+		if v == credentialEncryptedValue {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// recordedCredentialSecretHashes returns a copy of the hashes recorded in the prior state. The
+// planned ones are unknown during an apply whenever a secret did not match its hash, so
+// reading them with Get would find none.
+func recordedCredentialSecretHashes(d *schema.ResourceData) map[string]interface{} {
+	old, _ := d.GetChange("secret_hashes")
+	hashes := make(map[string]interface{})
+	for k, v := range old.(map[string]interface{}) {
+		hashes[k] = v
+	}
+	return hashes
+}
+
+// recordCredentialSecrets records the hashes of the secret inputs of the payload just
+// written and the modification time AWX returned for it. Secrets sent as $encrypted$ were
+// kept by AWX and keep their hash, empty ones were removed.
+func recordCredentialSecrets(d *schema.ResourceData, cred *awx.Credential, payload map[string]interface{}, keys ...string) error {
+	hashes := recordedCredentialSecretHashes(d)
+	inputs := payload["inputs"].(map[string]interface{})
+	for _, key := range keys {
+		value, _ := inputs[key].(string)
+		switch value {
+		case credentialEncryptedValue:
+		case "":
+			delete(hashes, key)
+		default:
+			hash, err := newCredentialSecretHash(value)
+			if err != nil {
+				return err
+			}
+			hashes[key] = hash
+		}
+	}
+	if err := d.Set("secret_hashes", hashes); err != nil {
+		return err
+	}
+	return d.Set("modified", cred.Modified.Format(time.RFC3339Nano))
+}
+
+// checkCredentialModified warns when the credential was modified outside of Terraform since
+// it was last written, as its secrets may have been rotated. The recorded hashes are then
+// emptied so that the next apply sends the configured secrets again.
+func checkCredentialModified(d *schema.ResourceData, cred *awx.Credential) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	modified := cred.Modified.Format(time.RFC3339Nano)
+	if previous := d.Get("modified").(string); previous != "" && previous != modified {
+		hashes := d.Get("secret_hashes").(map[string]interface{})
+		for k := range hashes {
+			hashes[k] = ""
+		}
+		if err := d.Set("secret_hashes", hashes); err != nil {
+			return diag.FromErr(err)
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Credential modified outside of Terraform",
+			Detail: "Credential " + d.Id() + " was modified in AWX at " + modified + ", after Terraform last wrote it at " +
+				previous + ". Its secrets can not be read back and will be sent again on the next apply.",
+		})
+	}
+	if err := d.Set("modified", modified); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+// customizeDiffCredentialSecrets plans an update of the credential when a configured secret
+// does not match its recorded hash, such as after a change outside of Terraform.
+// Secrets without a recorded hash, written by older versions of the provider, are left alone.
+func customizeDiffCredentialSecrets(keys ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+		hashes := d.Get("secret_hashes").(map[string]interface{})
+		for _, key := range keys {
+			hash, recorded := hashes[key]
+			if !recorded {
+				continue
+			}
+			if !d.NewValueKnown(key) || !credentialSecretMatches(hash, d.Get(key).(string)) {
+				return d.SetNewComputed("secret_hashes")
+			}
+		}
+		return nil
+	}
+}
+
+// customizeDiffCredentialInputSecrets is customizeDiffCredentialSecrets for the secrets of the
+// inputs of `awx_credential`, which are the inputs with a recorded hash.
+func customizeDiffCredentialInputSecrets(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if !d.NewValueKnown("inputs") || !d.NewValueKnown("sensitive_inputs") {
+		return d.SetNewComputed("secret_hashes")
+	}
+	inputs, err := mergeCredentialInputs(d.Get("inputs").(string), d.Get("sensitive_inputs").(string))
+	if err != nil {
+		return err
+	}
+	for key, hash := range d.Get("secret_hashes").(map[string]interface{}) {
+		value, _ := inputs[key].(string)
+		if !credentialSecretMatches(hash, value) {
+			return d.SetNewComputed("secret_hashes")
+		}
+	}
+	return nil
+}
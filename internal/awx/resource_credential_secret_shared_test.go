@@ -0,0 +1,152 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+// fakeCredentialAWX serves a single machine credential, 5, masking its secrets like AWX and
+// recording the inputs of every write.
+type fakeCredentialAWX struct {
+	mu       sync.Mutex
+	inputs   map[string]interface{}
+	modified time.Time
+	writes   []map[string]interface{}
+}
+
+func (f *fakeCredentialAWX) credential() map[string]interface{} {
+	masked := make(map[string]interface{}, len(f.inputs))
+	for k, v := range f.inputs {
+		if s, _ := v.(string); s != "" && (k == "password" || k == "ssh_key_data" || k == "ssh_key_unlock" || k == "become_password") {
+			v = credentialEncryptedValue
+		}
+		masked[k] = v
+	}
+	return map[string]interface{}{
+		"id": 5, "name": "machine", "organization": 1, "credential_type": 1,
+		"inputs": masked, "modified": f.modified.Format(time.RFC3339Nano),
+	}
+}
+
+// rotate changes a secret as an AWX user would, outside of Terraform.
+func (f *fakeCredentialAWX) rotate(key, value string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.inputs[key] = value
+	f.modified = f.modified.Add(time.Minute)
+}
+
+func (f *fakeCredentialAWX) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.URL.Path == "/api/v2/ping/":
+		fmt.Fprint(w, `{}`)
+	case r.URL.Path == "/api/v2/credential_types/":
+		fmt.Fprint(w, `{"count": 1, "next": null, "results": [{"id": 1, "namespace": "ssh", "kind": "ssh", "managed": true}]}`)
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v2/credentials/",
+		r.Method == http.MethodPatch && r.URL.Path == "/api/v2/credentials/5/":
+		var payload struct {
+			Inputs map[string]interface{} `json:"inputs"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.writes = append(f.writes, payload.Inputs)
+		if f.inputs == nil {
+			f.inputs = make(map[string]interface{})
+		}
+		for k, v := range payload.Inputs {
+			if v != credentialEncryptedValue {
+				f.inputs[k] = v
+			}
+		}
+		f.modified = f.modified.Add(time.Second)
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+		_ = json.NewEncoder(w).Encode(f.credential())
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v2/credentials/5/":
+		_ = json.NewEncoder(w).Encode(f.credential())
+	default:
+		http.Error(w, `{"detail": "Not found."}`, http.StatusNotFound)
+	}
+}
+
+func TestCredentialSecretDriftIsReapplied(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeCredentialAWX{modified: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	client, err := awx.NewAWX(srv.URL, "admin", "password", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := resourceCredentialMachine()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":            "machine",
+		"organization_id": 1,
+		"username":        "ansible",
+		"password":        "s3cret",
+	})
+	apply := func(state *terraform.InstanceState) *terraform.InstanceState {
+		t.Helper()
+		diff, err := r.Diff(ctx, state, config, client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff == nil || diff.Empty() {
+			t.Fatal("Expecting a change to apply")
+		}
+		state, diags := r.Apply(ctx, state, diff, client)
+		if diags.HasError() {
+			t.Fatalf("Unexpected apply diagnostics %v", diags)
+		}
+		return state
+	}
+	refresh := func(state *terraform.InstanceState) *terraform.InstanceState {
+		t.Helper()
+		state, diags := r.RefreshWithoutUpgrade(ctx, state, client)
+		if diags.HasError() {
+			t.Fatalf("Unexpected refresh diagnostics %v", diags)
+		}
+		return state
+	}
+
+	state := refresh(apply(nil))
+	if diff, err := r.Diff(ctx, state, config, client); err != nil || (diff != nil && !diff.Empty()) {
+		t.Fatalf("Expecting no change after the creation but got %v, %v", diff, err)
+	}
+
+	fake.rotate("password", "rotated")
+	state = refresh(state)
+	if hash := state.Attributes["secret_hashes.password"]; hash != "" {
+		t.Fatalf("Expecting the hash of the rotated password to be emptied but got %q", hash)
+	}
+
+	state = refresh(apply(state))
+	if got := fake.writes[len(fake.writes)-1]["password"]; got != "s3cret" {
+		t.Fatalf("Expecting the configured password to be sent again but got %v", got)
+	}
+	if fake.inputs["password"] != "s3cret" {
+		t.Fatalf("Expecting the drift to be fixed in AWX but got %v", fake.inputs["password"])
+	}
+	if hash := state.Attributes["secret_hashes.password"]; !credentialSecretMatches(hash, "s3cret") {
+		t.Fatalf("Expecting the password hash to be recorded again but got %q", hash)
+	}
+	if diff, err := r.Diff(ctx, state, config, client); err != nil || (diff != nil && !diff.Empty()) {
+		t.Fatalf("Expecting no change after fixing the drift but got %v, %v", diff, err)
+	}
+}
@@ -0,0 +1,178 @@
+package awx
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
+)
+
+const diagCredentialThycoticSecretServerTitle = "Thycotic Secret Server Credential"
+
+func resourceCredentialThycoticSecretServer() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`awx_credential_thycotic_secret_server` manages Thycotic Secret Server credentials in AWX, a source for `awx_credential_input_source`.",
+		CreateContext: resourceCredentialThycoticSecretServerCreate,
+		ReadContext:   resourceCredentialThycoticSecretServerRead,
+		UpdateContext: resourceCredentialThycoticSecretServerUpdate,
+		DeleteContext: resourceCredentialDelete,
+		Importer:      organizationNaturalKeyImporter("credential", listCredentialIDs),
+		CustomizeDiff: customizeDiffCredentialSecrets("password"),
+		Schema: withCredentialSecretSchemas(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the credential.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the credential.",
+			},
+			"organization_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The organization ID this credential belongs to.",
+			},
+			"validate_on_create": validateOnCreateSchema(
+				"Check that AWX can look up the secret described by `validation_metadata` before creating the credential."),
+			"validation_metadata": validationMetadataSchema(),
+			"server_url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The base URL of the Secret Server, such as `https://example.secretservercloud.com/SecretServer`.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The username of the Secret Server user.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of the Secret Server user.",
+			},
+		}),
+	}
+}
+
+func resourceCredentialThycoticSecretServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	credentialTypeID, err := resolveManagedCredentialType(client, thycoticSecretServerCredentialType)
+	if err != nil {
+		return utils.DiagCreate(diagCredentialThycoticSecretServerTitle, err)
+	}
+
+	payload := map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"description":     d.Get("description").(string),
+		"organization":    d.Get("organization_id").(int),
+		"credential_type": credentialTypeID,
+		"inputs": map[string]interface{}{
+			"server_url": d.Get("server_url").(string),
+			"username":   d.Get("username").(string),
+			"password":   d.Get("password").(string),
+		},
+	}
+
+	if err := validateLookupCredentialOnCreate(d, client, credentialTypeID, payload); err != nil {
+		return utils.DiagCreate(diagCredentialThycoticSecretServerTitle, err)
+	}
+
+	cred, err := client.CredentialsService.CreateCredentials(payload, map[string]string{})
+	if err != nil {
+		return utils.DiagCreate(diagCredentialThycoticSecretServerTitle, err)
+	}
+
+	d.SetId(strconv.Itoa(cred.ID))
+	if err := recordCredentialSecrets(d, cred, payload, "password"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCredentialThycoticSecretServerRead(ctx, d, m)
+}
+
+func resourceCredentialThycoticSecretServerRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return utils.DiagFetch(diagCredentialThycoticSecretServerTitle, d.Id(), err)
+	}
+	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	if err != nil {
+		return utils.DiagFetch(diagCredentialThycoticSecretServerTitle, d.Id(), err)
+	}
+
+	if err := d.Set("name", cred.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", cred.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("organization_id", cred.OrganizationID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("server_url", cred.Inputs["server_url"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("username", cred.Inputs["username"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCredentialSecret(d, "password", cred.Inputs["password"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return checkCredentialModified(d, cred)
+}
+
+func resourceCredentialThycoticSecretServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	keys := []string{
+		"name",
+		"description",
+		"organization_id",
+		"server_url",
+		"username",
+		"password",
+		"secret_hashes",
+	}
+
+	if d.HasChanges(keys...) {
+		id, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialThycoticSecretServerTitle, d.Id(), err)
+		}
+		client := m.(*awx.AWX)
+		credentialTypeID, err := resolveManagedCredentialType(client, thycoticSecretServerCredentialType)
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialThycoticSecretServerTitle, d.Id(), err)
+		}
+
+		payload := map[string]interface{}{
+			"name":            d.Get("name").(string),
+			"description":     d.Get("description").(string),
+			"organization":    d.Get("organization_id").(int),
+			"credential_type": credentialTypeID,
+			"inputs": map[string]interface{}{
+				"server_url": d.Get("server_url").(string),
+				"username":   d.Get("username").(string),
+				"password":   d.Get("password").(string),
+			},
+		}
+		if err := keepCredentialSecrets(d, client, id, payload, "password"); err != nil {
+			return utils.DiagUpdate(diagCredentialThycoticSecretServerTitle, d.Id(), err)
+		}
+
+		updated, err := client.CredentialsService.UpdateCredentialsByID(id, payload, map[string]string{})
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialThycoticSecretServerTitle, d.Id(), err)
+		}
+		if err := recordCredentialSecrets(d, updated, payload, "password"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCredentialThycoticSecretServerRead(ctx, d, m)
+}
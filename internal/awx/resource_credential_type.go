@@ -18,6 +18,9 @@ func resourceCredentialType() *schema.Resource {
 		ReadContext:   resourceCredentialTypeRead,
 		UpdateContext: resourceCredentialTypeUpdate,
 		DeleteContext: resourceCredentialTypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -38,11 +41,13 @@ func resourceCredentialType() *schema.Resource {
 			"inputs": {
 				Type:        schema.TypeString,
 				Required:    true,
+				StateFunc:   utils.Normalize,
 				Description: "Inputs for this credential type.",
 			},
 			"injectors": {
 				Type:        schema.TypeString,
 				Required:    true,
+				StateFunc:   utils.Normalize,
 				Description: "Injectors for this credential type.",
 			},
 		},
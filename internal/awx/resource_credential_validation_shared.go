@@ -0,0 +1,44 @@
+package awx
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+func validateOnCreateSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: description,
+	}
+}
+
+func validationMetadataSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeMap,
+		Optional:     true,
+		Elem:         &schema.Schema{Type: schema.TypeString},
+		RequiredWith: []string{"validate_on_create"},
+		Description: "The metadata of a secret to look up when `validate_on_create` is set, such as its " +
+			"`secret_path` and `secret_key`. It is only used for the validation.",
+	}
+}
+
+// validateLookupCredentialOnCreate checks, when `validate_on_create` is set, that AWX can look up
+// the secret described by `validation_metadata` with the inputs of the credential payload,
+// so that a credential which can not resolve anything is never created.
+func validateLookupCredentialOnCreate(d *schema.ResourceData, client *awx.AWX, credentialTypeID int, payload map[string]interface{}) error {
+	if !d.Get("validate_on_create").(bool) {
+		return nil
+	}
+
+	inputs := payload["inputs"].(map[string]interface{})
+	metadata := d.Get("validation_metadata").(map[string]interface{})
+	if err := client.CredentialTypeService.TestCredentialType(credentialTypeID, inputs, metadata, map[string]string{}); err != nil {
+		return fmt.Errorf("the credential failed to look up a secret with %v, got %w", metadata, err)
+	}
+	return nil
+}
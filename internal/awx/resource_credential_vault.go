@@ -8,10 +8,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
-const vaultCredentialTypeName = "Vault" //nolint:gosec
-
 func resourceCredentialVault() *schema.Resource {
 	return &schema.Resource{
 		Description:   "`awx_credential_vault` manages vault credentials in AWX.",
@@ -19,7 +18,9 @@ func resourceCredentialVault() *schema.Resource {
 		ReadContext:   resourceCredentialVaultRead,
 		UpdateContext: resourceCredentialVaultUpdate,
 		DeleteContext: resourceCredentialDelete,
-		Schema: map[string]*schema.Schema{
+		Importer:      organizationNaturalKeyImporter("credential", listCredentialIDs),
+		CustomizeDiff: customizeDiffCredentialSecrets("vault_password"),
+		Schema: withCredentialSecretSchemas(map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -46,7 +47,7 @@ func resourceCredentialVault() *schema.Resource {
 				Optional:    true,
 				Description: "The vault identity to use.",
 			},
-		},
+		}),
 	}
 }
 
@@ -55,21 +56,16 @@ func resourceCredentialVaultCreate(ctx context.Context, d *schema.ResourceData,
 	var err error
 
 	client := m.(*awx.AWX)
-	vaultCredType, err := client.CredentialTypeService.GetCredentialTypeByName(vaultCredentialTypeName, map[string]string{})
+	credentialTypeID, err := resolveManagedCredentialType(client, vaultCredentialType)
 	if err != nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to create new credentials",
-			Detail:   fmt.Sprintf("Unable to fetch credential type with Name: %s. Error: %s", vaultCredentialTypeName, err.Error()),
-		})
-		return diags
+		return utils.DiagCreate(diagCredentialTitle, err)
 	}
 
 	newCredential := map[string]interface{}{
 		"name":            d.Get("name").(string),
 		"description":     d.Get("description").(string),
 		"organization":    d.Get("organization_id").(int),
-		"credential_type": vaultCredType.ID,
+		"credential_type": credentialTypeID,
 		"inputs": map[string]interface{}{
 			"vault_password": d.Get("vault_password").(string),
 			"vault_id":       d.Get("vault_id").(string),
@@ -87,9 +83,10 @@ func resourceCredentialVaultCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	d.SetId(strconv.Itoa(cred.ID))
-	resourceCredentialVaultRead(ctx, d, m)
-
-	return diags
+	if err := recordCredentialSecrets(d, cred, newCredential, "vault_password"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCredentialVaultRead(ctx, d, m)
 }
 
 func resourceCredentialVaultRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -116,14 +113,14 @@ func resourceCredentialVaultRead(_ context.Context, d *schema.ResourceData, m in
 	if err := d.Set("organization_id", cred.OrganizationID); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("vault_password", cred.Inputs["vault_password"]); err != nil {
+	if err := setCredentialSecret(d, "vault_password", cred.Inputs["vault_password"]); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("vault_id", cred.Inputs["vault_id"]); err != nil {
 		return diag.FromErr(err)
 	}
 
-	return diags
+	return append(diags, checkCredentialModified(d, cred)...)
 }
 
 func resourceCredentialVaultUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -135,6 +132,7 @@ func resourceCredentialVaultUpdate(ctx context.Context, d *schema.ResourceData,
 		"organization_id",
 		"vault_password",
 		"vault_id",
+		"secret_hashes",
 	}
 
 	client := m.(*awx.AWX)
@@ -142,14 +140,9 @@ func resourceCredentialVaultUpdate(ctx context.Context, d *schema.ResourceData,
 	if d.HasChanges(keys...) {
 		var err error
 
-		vaultCredType, err := client.CredentialTypeService.GetCredentialTypeByName(vaultCredentialTypeName, map[string]string{})
+		credentialTypeID, err := resolveManagedCredentialType(client, vaultCredentialType)
 		if err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Unable to create new credentials",
-				Detail:   fmt.Sprintf("Unable to fetch credential type with Name: %s. Error: %s", vaultCredentialTypeName, err.Error()),
-			})
-			return diags
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
 		}
 
 		id, _ := strconv.Atoi(d.Id())
@@ -157,14 +150,18 @@ func resourceCredentialVaultUpdate(ctx context.Context, d *schema.ResourceData,
 			"name":            d.Get("name").(string),
 			"description":     d.Get("description").(string),
 			"organization":    d.Get("organization_id").(int),
-			"credential_type": vaultCredType.ID,
+			"credential_type": credentialTypeID,
 			"inputs": map[string]interface{}{
 				"vault_password": d.Get("vault_password").(string),
 				"vault_id":       d.Get("vault_id").(string),
 			},
 		}
 
-		_, err = client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
+		if err := keepCredentialSecrets(d, client, id, updatedCredential, "vault_password"); err != nil {
+			return utils.DiagUpdate(diagCredentialTitle, d.Id(), err)
+		}
+
+		updated, err := client.CredentialsService.UpdateCredentialsByID(id, updatedCredential, map[string]string{})
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -173,6 +170,9 @@ func resourceCredentialVaultUpdate(ctx context.Context, d *schema.ResourceData,
 			})
 			return diags
 		}
+		if err := recordCredentialSecrets(d, updated, updatedCredential, "vault_password"); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceCredentialVaultRead(ctx, d, m)
@@ -0,0 +1,171 @@
+package awx
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
+)
+
+const diagCredentialVMwareVCenterTitle = "VMware vCenter Credential"
+
+func resourceCredentialVMwareVCenter() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`awx_credential_vmware_vcenter` manages VMware vCenter credentials in AWX.",
+		CreateContext: resourceCredentialVMwareVCenterCreate,
+		ReadContext:   resourceCredentialVMwareVCenterRead,
+		UpdateContext: resourceCredentialVMwareVCenterUpdate,
+		DeleteContext: resourceCredentialDelete,
+		Importer:      organizationNaturalKeyImporter("credential", listCredentialIDs),
+		CustomizeDiff: customizeDiffCredentialSecrets("password"),
+		Schema: withCredentialSecretSchemas(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the credential.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the credential.",
+			},
+			"organization_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The organization ID this credential belongs to.",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The hostname or IP address of the vCenter host.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The username of the credential.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of the credential.",
+			},
+		}),
+	}
+}
+
+func resourceCredentialVMwareVCenterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	credentialTypeID, err := resolveManagedCredentialType(client, vmwareCredentialType)
+	if err != nil {
+		return utils.DiagCreate(diagCredentialVMwareVCenterTitle, err)
+	}
+
+	payload := map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"description":     d.Get("description").(string),
+		"organization":    d.Get("organization_id").(int),
+		"credential_type": credentialTypeID,
+		"inputs": map[string]interface{}{
+			"host":     d.Get("host").(string),
+			"username": d.Get("username").(string),
+			"password": d.Get("password").(string),
+		},
+	}
+
+	cred, err := client.CredentialsService.CreateCredentials(payload, map[string]string{})
+	if err != nil {
+		return utils.DiagCreate(diagCredentialVMwareVCenterTitle, err)
+	}
+
+	d.SetId(strconv.Itoa(cred.ID))
+	if err := recordCredentialSecrets(d, cred, payload, "password"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCredentialVMwareVCenterRead(ctx, d, m)
+}
+
+func resourceCredentialVMwareVCenterRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return utils.DiagFetch(diagCredentialVMwareVCenterTitle, d.Id(), err)
+	}
+	cred, err := client.CredentialsService.GetCredentialsByID(id, map[string]string{})
+	if err != nil {
+		return utils.DiagFetch(diagCredentialVMwareVCenterTitle, d.Id(), err)
+	}
+
+	if err := d.Set("name", cred.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", cred.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("organization_id", cred.OrganizationID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("host", cred.Inputs["host"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("username", cred.Inputs["username"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCredentialSecret(d, "password", cred.Inputs["password"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return checkCredentialModified(d, cred)
+}
+
+func resourceCredentialVMwareVCenterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	keys := []string{
+		"name",
+		"description",
+		"organization_id",
+		"host",
+		"username",
+		"password",
+		"secret_hashes",
+	}
+
+	if d.HasChanges(keys...) {
+		id, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialVMwareVCenterTitle, d.Id(), err)
+		}
+		client := m.(*awx.AWX)
+		credentialTypeID, err := resolveManagedCredentialType(client, vmwareCredentialType)
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialVMwareVCenterTitle, d.Id(), err)
+		}
+
+		payload := map[string]interface{}{
+			"name":            d.Get("name").(string),
+			"description":     d.Get("description").(string),
+			"organization":    d.Get("organization_id").(int),
+			"credential_type": credentialTypeID,
+			"inputs": map[string]interface{}{
+				"host":     d.Get("host").(string),
+				"username": d.Get("username").(string),
+				"password": d.Get("password").(string),
+			},
+		}
+		if err := keepCredentialSecrets(d, client, id, payload, "password"); err != nil {
+			return utils.DiagUpdate(diagCredentialVMwareVCenterTitle, d.Id(), err)
+		}
+
+		updated, err := client.CredentialsService.UpdateCredentialsByID(id, payload, map[string]string{})
+		if err != nil {
+			return utils.DiagUpdate(diagCredentialVMwareVCenterTitle, d.Id(), err)
+		}
+		if err := recordCredentialSecrets(d, updated, payload, "password"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCredentialVMwareVCenterRead(ctx, d, m)
+}
@@ -22,6 +22,7 @@ func resourceHost() *schema.Resource {
 		UpdateContext: resourceHostUpdate,
 
 		Schema: map[string]*schema.Schema{
+			"adopt_existing": adoptExistingSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -56,21 +57,20 @@ func resourceHost() *schema.Resource {
 				Default:     "",
 				Description: "The instance id of the host",
 			},
-			"variables": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				StateFunc:   utils.Normalize,
-				Description: "The variables of the host",
-			},
-		},
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			"variables":     utils.VariablesSchema("The variables of the host, in YAML or JSON format"),
+			"variables_map": utils.VariablesMapSchema("The variables of the host as a map, exclusive with `variables`. Values holding JSON, such as numbers, booleans or `jsonencode` results, keep their type.", "variables"),
 		},
+		Importer: inventoryNaturalKeyImporter("host", listHostIDs),
 	}
 }
 
 func resourceHostCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if done, diags := adoptExisting(ctx, d, m, "host", listHostIDs, map[string]string{
+		"name":      d.Get("name").(string),
+		"inventory": strconv.Itoa(d.Get("inventory_id").(int)),
+	}, resourceHostUpdate); done {
+		return diags
+	}
 
 	client := m.(*awx.AWX)
 	awxService := client.HostService
@@ -81,7 +81,7 @@ func resourceHostCreate(ctx context.Context, d *schema.ResourceData, m interface
 		"inventory":   d.Get("inventory_id").(int),
 		"enabled":     d.Get("enabled").(bool),
 		"instance_id": d.Get("instance_id").(string),
-		"variables":   d.Get("variables").(string),
+		"variables":   utils.Variables(d, "variables", "variables_map"),
 	}, map[string]string{})
 	if err != nil {
 		return utils.DiagCreate(diagHostTitle, err)
@@ -116,7 +116,7 @@ func resourceHostUpdate(ctx context.Context, d *schema.ResourceData, m interface
 		"inventory":   d.Get("inventory_id").(int),
 		"enabled":     d.Get("enabled").(bool),
 		"instance_id": d.Get("instance_id").(string),
-		"variables":   d.Get("variables").(string),
+		"variables":   utils.Variables(d, "variables", "variables_map"),
 	}, nil); err != nil {
 		return utils.DiagUpdate(diagHostTitle, id, err)
 	}
@@ -181,7 +181,7 @@ func setHostResourceData(d *schema.ResourceData, r *awx.Host) *schema.ResourceDa
 	if err := d.Set("instance_id", r.InstanceID); err != nil {
 		fmt.Println("Error setting instance_id", err)
 	}
-	if err := d.Set("variables", utils.Normalize(r.Variables)); err != nil {
+	if err := utils.SetVariables(d, "variables", "variables_map", r.Variables); err != nil {
 		fmt.Println("Error setting variables", err)
 	}
 	if err := d.Set("group_ids", d.Get("group_ids").([]interface{})); err != nil {
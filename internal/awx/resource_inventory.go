@@ -20,6 +20,7 @@ func resourceInventory() *schema.Resource {
 		UpdateContext: resourceInventoryUpdate,
 
 		Schema: map[string]*schema.Schema{
+			"adopt_existing": adoptExistingSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -48,21 +49,21 @@ func resourceInventory() *schema.Resource {
 				Default:     "",
 				Description: "The host filter of the inventory",
 			},
-			"variables": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				StateFunc:   utils.Normalize,
-				Description: "The variables of the inventory",
-			},
-		},
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			"variables":     utils.VariablesSchema("The variables of the inventory, in YAML or JSON format"),
+			"variables_map": utils.VariablesMapSchema("The variables of the inventory as a map, exclusive with `variables`. Values holding JSON, such as numbers, booleans or `jsonencode` results, keep their type.", "variables"),
 		},
+		Importer: organizationNaturalKeyImporter("inventory", listInventoryIDs),
 	}
 }
 
 func resourceInventoryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if done, diags := adoptExisting(ctx, d, m, "inventory", listInventoryIDs, map[string]string{
+		"name":         d.Get("name").(string),
+		"organization": d.Get("organization_id").(string),
+	}, resourceInventoryUpdate); done {
+		return diags
+	}
+
 	client := m.(*awx.AWX)
 	result, err := client.InventoriesService.CreateInventory(map[string]interface{}{
 		"name":         d.Get("name").(string),
@@ -70,7 +71,7 @@ func resourceInventoryCreate(ctx context.Context, d *schema.ResourceData, m inte
 		"description":  d.Get("description").(string),
 		"kind":         d.Get("kind").(string),
 		"host_filter":  d.Get("host_filter").(string),
-		"variables":    d.Get("variables").(string),
+		"variables":    utils.Variables(d, "variables", "variables_map"),
 	}, map[string]string{})
 	if err != nil {
 		return utils.DiagCreate(diagInventoryTitle, err)
@@ -93,7 +94,7 @@ func resourceInventoryUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		"description":  d.Get("description").(string),
 		"kind":         d.Get("kind").(string),
 		"host_filter":  d.Get("host_filter").(string),
-		"variables":    d.Get("variables").(string),
+		"variables":    utils.Variables(d, "variables", "variables_map"),
 	}, nil); err != nil {
 		return utils.DiagUpdate(diagInventoryTitle, id, err)
 	}
@@ -147,7 +148,7 @@ func setInventoryResourceData(d *schema.ResourceData, r *awx.Inventory) *schema.
 	if err := d.Set("host_filter", r.HostFilter); err != nil {
 		fmt.Println("Error setting host_filter", err)
 	}
-	if err := d.Set("variables", utils.Normalize(r.Variables)); err != nil {
+	if err := utils.SetVariables(d, "variables", "variables_map", r.Variables); err != nil {
 		fmt.Println("Error setting variables", err)
 	}
 	d.SetId(strconv.Itoa(r.ID))
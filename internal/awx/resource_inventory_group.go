@@ -20,6 +20,7 @@ func resourceInventoryGroup() *schema.Resource {
 		DeleteContext: resourceInventoryGroupDelete,
 
 		Schema: map[string]*schema.Schema{
+			"adopt_existing": adoptExistingSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -37,21 +38,20 @@ func resourceInventoryGroup() *schema.Resource {
 				ForceNew:    true,
 				Description: "The inventory id of the group",
 			},
-			"variables": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				StateFunc:   utils.Normalize,
-				Description: `The variables of the group. This can be in JSON or YAML format. For example:  {"key": "value"}`,
-			},
-		},
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			"variables":     utils.VariablesSchema(`The variables of the group. This can be in JSON or YAML format. For example:  {"key": "value"}`),
+			"variables_map": utils.VariablesMapSchema("The variables of the group as a map, exclusive with `variables`. Values holding JSON, such as numbers, booleans or `jsonencode` results, keep their type.", "variables"),
 		},
+		Importer: inventoryNaturalKeyImporter("group", listGroupIDs),
 	}
 }
 
 func resourceInventoryGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if done, diags := adoptExisting(ctx, d, m, "group", listGroupIDs, map[string]string{
+		"name":      d.Get("name").(string),
+		"inventory": d.Get("inventory_id").(string),
+	}, resourceInventoryGroupUpdate); done {
+		return diags
+	}
 
 	client := m.(*awx.AWX)
 	awxService := client.GroupService
@@ -60,7 +60,7 @@ func resourceInventoryGroupCreate(ctx context.Context, d *schema.ResourceData, m
 		"name":        d.Get("name").(string),
 		"description": d.Get("description").(string),
 		"inventory":   d.Get("inventory_id").(string),
-		"variables":   d.Get("variables").(string),
+		"variables":   utils.Variables(d, "variables", "variables_map"),
 	}, map[string]string{})
 	if err != nil {
 		return utils.DiagCreate(diagInventoryGroupTitle, err)
@@ -82,7 +82,7 @@ func resourceInventoryGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 		"name":        d.Get("name").(string),
 		"description": d.Get("description").(string),
 		"inventory":   d.Get("inventory_id").(string),
-		"variables":   d.Get("variables").(string),
+		"variables":   utils.Variables(d, "variables", "variables_map"),
 	}, nil); err != nil {
 		return utils.DiagUpdate(diagInventoryGroupTitle, id, err)
 	}
@@ -130,7 +130,7 @@ func setInventoryGroupResourceData(d *schema.ResourceData, r *awx.Group) *schema
 	if err := d.Set("inventory_id", r.Inventory); err != nil {
 		fmt.Println("Error setting inventory_id", err)
 	}
-	if err := d.Set("variables", utils.Normalize(r.Variables)); err != nil {
+	if err := utils.SetVariables(d, "variables", "variables_map", r.Variables); err != nil {
 		fmt.Println("Error setting variables", err)
 	}
 
@@ -2,7 +2,6 @@ package awx
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -32,6 +31,7 @@ func resourceInventoryInstanceGroups() *schema.Resource {
 				Description: "The ID of the instance group to associate with the inventory",
 			},
 		},
+		Importer: associationImporter("inventory_id", "instance_group_id"),
 	}
 }
 
@@ -42,7 +42,7 @@ func resourceInventoryInstanceGroupsCreate(_ context.Context, d *schema.Resource
 		return utils.DiagNotFound("Inventory InstanceGroup", inventoryID, err)
 	}
 
-	result, err := client.InventoriesService.AssociateInstanceGroups(inventoryID, map[string]interface{}{
+	_, err := client.InventoriesService.AssociateInstanceGroups(inventoryID, map[string]interface{}{
 		"id": d.Get("instance_group_id").(int),
 	}, map[string]string{})
 
@@ -50,12 +50,16 @@ func resourceInventoryInstanceGroupsCreate(_ context.Context, d *schema.Resource
 		return utils.DiagCreate("Inventory AssociateInstanceGroups", err)
 	}
 
-	d.SetId(strconv.Itoa(result.ID))
+	d.SetId(utils.CompositeID(inventoryID, d.Get("instance_group_id").(int)))
 	return nil
 }
 
-func resourceInventoryInstanceGroupsRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	return nil
+func resourceInventoryInstanceGroupsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	return readAssociation(d, "Inventory InstanceGroup", "inventory_id", "instance_group_id", func(parentID int, params map[string]string) (bool, error) {
+		res, _, err := client.InventoriesService.ListInstanceGroups(parentID, params)
+		return len(res) > 0, err
+	})
 }
 
 func resourceInventoryInstanceGroupsDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -23,6 +23,7 @@ func resourceInventorySource() *schema.Resource {
 		DeleteContext: resourceInventorySourceDelete,
 
 		Schema: map[string]*schema.Schema{
+			"adopt_existing": adoptExistingSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -77,11 +78,7 @@ func resourceInventorySource() *schema.Resource {
 				Optional:    true,
 				Description: "The source of the inventory source.",
 			},
-			"source_vars": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The variables for the inventory source.",
-			},
+			"source_vars": utils.VariablesSchema("The variables for the inventory source, in YAML or JSON format."),
 			"host_filter": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -132,13 +129,18 @@ func resourceInventorySource() *schema.Resource {
 				Description: "The selected execution environment that this playbook will be run in.",
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: inventoryNaturalKeyImporter("inventory source", listInventorySourceIDs),
 	}
 }
 
 func resourceInventorySourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if done, diags := adoptExisting(ctx, d, m, "inventory source", listInventorySourceIDs, map[string]string{
+		"name":      d.Get("name").(string),
+		"inventory": strconv.Itoa(d.Get("inventory_id").(int)),
+	}, resourceInventorySourceUpdate); done {
+		return diags
+	}
+
 	client := m.(*awx.AWX)
 
 	payload := map[string]interface{}{
@@ -282,7 +284,7 @@ func setInventorySourceResourceData(d *schema.ResourceData, r *awx.InventorySour
 	if err := d.Set("source", r.Source); err != nil {
 		fmt.Println("Error setting source", err)
 	}
-	if err := d.Set("source_vars", utils.Normalize(r.SourceVars)); err != nil {
+	if err := d.Set("source_vars", r.SourceVars); err != nil {
 		fmt.Println("Error setting source_vars", err)
 	}
 	if err := d.Set("host_filter", r.HostFilter); err != nil {
@@ -0,0 +1,145 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+// jobCancelTimeout bounds the wait for a job canceled after the launch timed out, as the
+// timeout of the launch is spent by then.
+const jobCancelTimeout = 5 * time.Minute
+
+func cancelOnDestroySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Cancel the job when the resource is destroyed while the job runs, and wait for it to stop.",
+	}
+}
+
+func cancelOnTimeoutSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "Cancel the job when `wait_for_completion` times out, and wait for it to stop, instead of " +
+			"leaving it running in AWX.",
+	}
+}
+
+// jobCanceler reads, cancels and waits for the jobs of a kind, such as playbook jobs or ad hoc commands.
+type jobCanceler struct {
+	status func(id int) (string, error)
+	cancel func(id int) error
+	wait   func(ctx context.Context, id int, opts *awx.JobWaitOptions) error
+}
+
+func playbookJobCanceler(client *awx.AWX) jobCanceler {
+	return jobCanceler{
+		status: func(id int) (string, error) {
+			job, err := client.JobService.GetJob(id, map[string]string{})
+			if err != nil {
+				return "", err
+			}
+			return job.Status, nil
+		},
+		cancel: func(id int) error {
+			_, err := client.JobService.CancelJob(id, map[string]interface{}{}, map[string]string{})
+			return err
+		},
+		wait: func(ctx context.Context, id int, opts *awx.JobWaitOptions) error {
+			_, err := client.JobService.WaitForJob(ctx, id, opts)
+			return err
+		},
+	}
+}
+
+func workflowJobCanceler(client *awx.AWX) jobCanceler {
+	return jobCanceler{
+		status: func(id int) (string, error) {
+			job, err := client.WorkflowJobService.GetWorkflowJob(id, map[string]string{})
+			if err != nil {
+				return "", err
+			}
+			return job.Status, nil
+		},
+		cancel: func(id int) error {
+			_, err := client.WorkflowJobService.CancelWorkflowJob(id, map[string]interface{}{}, map[string]string{})
+			return err
+		},
+		wait: func(ctx context.Context, id int, opts *awx.JobWaitOptions) error {
+			_, err := client.WorkflowJobService.WaitForWorkflowJob(ctx, id, opts)
+			return err
+		},
+	}
+}
+
+func adHocCommandCanceler(client *awx.AWX) jobCanceler {
+	return jobCanceler{
+		status: func(id int) (string, error) {
+			cmd, err := client.AdHocCommandService.GetAdHocCommand(id, map[string]string{})
+			if err != nil {
+				return "", err
+			}
+			return cmd.Status, nil
+		},
+		cancel: func(id int) error {
+			_, err := client.AdHocCommandService.CancelAdHocCommand(id, map[string]interface{}{}, map[string]string{})
+			return err
+		},
+		wait: func(ctx context.Context, id int, opts *awx.JobWaitOptions) error {
+			_, err := client.AdHocCommandService.WaitForAdHocCommand(ctx, id, opts)
+			return err
+		},
+	}
+}
+
+// cancelJob cancels a job unless it has finished, then waits for it to reach a terminal status.
+// The wait does not end with ctx, so that a job is canceled even once the launch timed out.
+func cancelJob(ctx context.Context, c jobCanceler, jobID int, timeout time.Duration) error {
+	status, err := c.status(jobID)
+	if err != nil {
+		return err
+	}
+	if awx.IsJobFinished(status) {
+		return nil
+	}
+	if err := c.cancel(jobID); err != nil {
+		return fmt.Errorf("unable to cancel job %d, got %w", jobID, err)
+	}
+
+	err = c.wait(context.WithoutCancel(ctx), jobID, &awx.JobWaitOptions{Timeout: timeout})
+	var waitErr *awx.JobWaitError
+	if errors.As(err, &waitErr) && waitErr.Outcome != awx.JobOutcomeTimeout {
+		return nil
+	}
+	return err
+}
+
+// cancelJobOnTimeout cancels a job whose wait timed out when `cancel_on_timeout` is set,
+// reporting the cancellation.
+func cancelJobOnTimeout(ctx context.Context, d *schema.ResourceData, c jobCanceler, jobID int, err error) diag.Diagnostics {
+	var waitErr *awx.JobWaitError
+	if !d.Get("cancel_on_timeout").(bool) || !errors.As(err, &waitErr) || waitErr.Outcome != awx.JobOutcomeTimeout {
+		return nil
+	}
+	if err := cancelJob(ctx, c, jobID, jobCancelTimeout); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unable to cancel Job",
+			Detail:   fmt.Sprintf("Job %d timed out and could not be canceled, it may still be running: %s", jobID, err),
+		}}
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Job canceled",
+		Detail:   fmt.Sprintf("Job %d was canceled as it did not complete before the timeout.", jobID),
+	}}
+}
@@ -0,0 +1,140 @@
+package awx
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+const (
+	// jobFailureMaxTasks and jobFailureMaxHosts bound the failing tasks and hosts listed in a
+	// diagnostic, the rest are counted.
+	jobFailureMaxTasks = 10
+	jobFailureMaxHosts = 20
+	// jobFailureMaxMessage bounds the message of a failing task, as modules may return
+	// whole command outputs.
+	jobFailureMaxMessage = 300
+)
+
+// jobFailureSummary describes the failing tasks and the results of the failing hosts of a job,
+// from its events and host summaries, so that a failure can be triaged from the Terraform output.
+func jobFailureSummary(client *awx.AWX, jobID int) string {
+	var b strings.Builder
+
+	writeFailingTasks(&b, func(params map[string]string) ([]awx.JobEvent, int, error) {
+		events, res, err := client.JobService.GetJobEvents(jobID, params)
+		return events, res.Count, err
+	})
+
+	hosts, summaries, err := client.JobService.GetHostSummaries(jobID, map[string]string{
+		"failed":    "true",
+		"order_by":  "host_name",
+		"page_size": strconv.Itoa(jobFailureMaxHosts),
+	})
+	if err != nil {
+		fmt.Fprintf(&b, "\n\nUnable to fetch the host summaries, got %s", err)
+	} else if len(hosts) > 0 {
+		b.WriteString("\n\nFailing hosts:")
+		for _, h := range hosts {
+			fmt.Fprintf(&b, "\n- %s: ok=%d changed=%d failed=%d unreachable=%d", h.HostName, h.Ok, h.Changed, h.Failures, h.Dark)
+		}
+		if more := summaries.Count - len(hosts); more > 0 {
+			fmt.Fprintf(&b, "\n- and %d more", more)
+		}
+	}
+
+	return b.String()
+}
+
+// adHocCommandFailureSummary describes the hosts an ad hoc command failed on, from its events.
+func adHocCommandFailureSummary(client *awx.AWX, id int) string {
+	var b strings.Builder
+	writeFailingTasks(&b, func(params map[string]string) ([]awx.JobEvent, int, error) {
+		events, res, err := client.AdHocCommandService.GetAdHocCommandEvents(id, params)
+		return events, res.Count, err
+	})
+	return b.String()
+}
+
+// writeFailingTasks describes the first failing tasks of a job, from its events listed by listEvents
+// with the count of the events matching the params.
+func writeFailingTasks(b *strings.Builder, listEvents func(params map[string]string) ([]awx.JobEvent, int, error)) {
+	// AWX does not flag the failures of tasks ignoring errors as failed, as they do not fail the job.
+	events, count, err := listEvents(map[string]string{
+		"event__in": awx.JobEventRunnerOnFailed + "," + awx.JobEventRunnerOnUnreachable,
+		"failed":    "true",
+		"order_by":  "counter",
+		"page_size": strconv.Itoa(jobFailureMaxTasks),
+	})
+	if err != nil {
+		fmt.Fprintf(b, "\n\nUnable to fetch the failing tasks, got %s", err)
+		return
+	}
+	if len(events) > 0 {
+		b.WriteString("\n\nFailing tasks:")
+		for _, e := range events {
+			b.WriteString("\n- " + jobEventFailure(e))
+		}
+		if more := count - len(events); more > 0 {
+			fmt.Fprintf(b, "\n- and %d more", more)
+		}
+	}
+}
+
+// jobEventFailure describes a failing task event as host, task, module and message.
+func jobEventFailure(e awx.JobEvent) string {
+	host := e.HostName
+	if e.Event == awx.JobEventRunnerOnUnreachable {
+		host += " (unreachable)"
+	}
+	if e.EventData == nil {
+		return fmt.Sprintf("%s: %s", host, e.Task)
+	}
+
+	msg := ""
+	if res := e.EventData.Res; res != nil {
+		msg = jobEventMessage(res.Msg)
+		if msg == "" {
+			msg = res.Stderr
+		}
+	}
+	return fmt.Sprintf("%s: %s (%s): %s", host, e.Task, e.EventData.TaskAction, truncateJobEventMessage(msg))
+}
+
+// jobEventMessage formats the `msg` of a task result, a string or any JSON value.
+func jobEventMessage(msg interface{}) string {
+	switch v := msg.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	}
+}
+
+// truncateJobEventMessage keeps the first line of a message, within jobFailureMaxMessage runes.
+func truncateJobEventMessage(msg string) string {
+	msg = strings.TrimSpace(msg)
+	if msg == "" {
+		return "no message"
+	}
+	truncated := false
+	if i := strings.IndexByte(msg, '\n'); i >= 0 {
+		msg, truncated = msg[:i], true
+	}
+	if r := []rune(msg); len(r) > jobFailureMaxMessage {
+		msg, truncated = string(r[:jobFailureMaxMessage]), true
+	}
+	if truncated {
+		msg += "…"
+	}
+	return msg
+}
@@ -0,0 +1,26 @@
+package awx
+
+import (
+	"strings"
+	"testing"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+func TestWriteFailingTasks(t *testing.T) {
+	var b strings.Builder
+	writeFailingTasks(&b, func(params map[string]string) ([]awx.JobEvent, int, error) {
+		if params["failed"] != "true" {
+			t.Errorf("Expecting the failing events to be filtered by AWX but got %v", params)
+		}
+		return []awx.JobEvent{
+			{Event: awx.JobEventRunnerOnFailed, HostName: "web1", Task: "Restart"},
+			{Event: awx.JobEventRunnerOnUnreachable, HostName: "web2", Task: "Gathering Facts"},
+		}, 12, nil
+	})
+
+	want := "\n\nFailing tasks:\n- web1: Restart\n- web2 (unreachable): Gathering Facts\n- and 10 more"
+	if got := b.String(); got != want {
+		t.Fatalf("Expecting %q but got %q", want, got)
+	}
+}
@@ -0,0 +1,170 @@
+package awx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
+)
+
+const jobHostSummariesPageSize = 200
+
+func jobHostSummariesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The results of the job per host, by host name.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"host_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the host",
+				},
+				"ok": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of tasks which succeeded on the host",
+				},
+				"changed": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of tasks which changed the host",
+				},
+				"failed": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of tasks which failed on the host",
+				},
+				"unreachable": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of tasks for which the host was unreachable",
+				},
+				"skipped": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of tasks skipped on the host",
+				},
+			},
+		},
+	}
+}
+
+// listJobHostSummaries returns the host summaries of a job, from all pages.
+func listJobHostSummaries(client *awx.AWX, jobID int) ([]awx.HostSummary, error) {
+	var hosts []awx.HostSummary
+	for page := 1; ; page++ {
+		results, res, err := client.JobService.GetHostSummaries(jobID, map[string]string{
+			"order_by":  "host_name",
+			"page":      strconv.Itoa(page),
+			"page_size": strconv.Itoa(jobHostSummariesPageSize),
+		})
+		if err != nil {
+			return nil, err
+		}
+		hosts = append(hosts, results...)
+		if next, _ := res.Next.(string); next == "" {
+			return hosts, nil
+		}
+	}
+}
+
+// formatJobTime formats a job timestamp in RFC 3339, empty until it is set.
+func formatJobTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// setJobResultResourceData sets the computed results of a job: its status, timing, host
+// summaries and artifacts.
+func setJobResultResourceData(d *schema.ResourceData, job *awx.Job, hosts []awx.HostSummary) diag.Diagnostics {
+	summaries := make([]map[string]interface{}, 0, len(hosts))
+	for _, h := range hosts {
+		summaries = append(summaries, map[string]interface{}{
+			"host_name":   h.HostName,
+			"ok":          h.Ok,
+			"changed":     h.Changed,
+			"failed":      h.Failures,
+			"unreachable": h.Dark,
+			"skipped":     h.Skipped,
+		})
+	}
+
+	artifacts := ""
+	if len(job.Artifacts) > 0 {
+		b, err := json.Marshal(job.Artifacts)
+		if err != nil {
+			return utils.DiagSet("artifacts", job.ID, err)
+		}
+		artifacts = string(b)
+	}
+
+	for k, v := range map[string]interface{}{
+		"status":          job.Status,
+		"started":         formatJobTime(job.Started),
+		"finished":        formatJobTime(job.Finished),
+		"elapsed":         job.Elapsed,
+		"failed":          job.Failed,
+		"job_explanation": job.JobExplanation,
+		"host_summaries":  summaries,
+		"artifacts":       artifacts,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return utils.DiagSet(k, job.ID, err)
+		}
+	}
+	return nil
+}
+
+// diagJobPurged warns that a job of kind no longer exists in AWX, as jobs are purged by the
+// cleanup_jobs system job, rather than failing or dropping the resource, which would launch
+// it again. consequence tells what the provider does instead.
+func diagJobPurged(kind string, id int, consequence string) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%s not found", kind),
+		Detail:   fmt.Sprintf("%s %d no longer exists in AWX, it was likely purged by a cleanup job. %s", kind, id, consequence),
+	}}
+}
+
+// diagJobWait reports a job of kind launched from source, such as "template ID 5", that did not
+// succeed, with its outcome, explanation and the URL of its output, and for a failure the details
+// given by failureSummary, so that it can be looked into.
+func diagJobWait(kind, source string, err error, failureSummary func(jobID int) string) diag.Diagnostics {
+	var waitErr *awx.JobWaitError
+	if !errors.As(err, &waitErr) {
+		return utils.Diagf(
+			fmt.Sprintf("%s execution failure", kind),
+			"Unable to wait for the job of %s, got %s", source, err.Error(),
+		)
+	}
+
+	explanation := waitErr.Explanation
+	if explanation == "" {
+		explanation = "no explanation given"
+	}
+	if waitErr.Outcome == awx.JobOutcomeTimeout {
+		return utils.Diagf(
+			fmt.Sprintf("%s execution timeout", kind),
+			"Job %d of %s was still %s when the wait timed out, %s: %s", waitErr.ID, source,
+			waitErr.Status, explanation, waitErr.URL,
+		)
+	}
+	summary := ""
+	if waitErr.Outcome != awx.JobOutcomeCanceled {
+		summary = failureSummary(waitErr.ID)
+	}
+	return utils.Diagf(
+		fmt.Sprintf("%s execution %s", kind, waitErr.Outcome),
+		"Job %d of %s finished as %s, %s: %s%s", waitErr.ID, source, waitErr.Outcome, explanation, waitErr.URL, summary,
+	)
+}
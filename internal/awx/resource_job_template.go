@@ -24,6 +24,7 @@ func resourceJobTemplate() *schema.Resource {
 		DeleteContext: resourceJobTemplateDelete,
 
 		Schema: map[string]*schema.Schema{
+			"adopt_existing": adoptExistingSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -85,12 +86,8 @@ func resourceJobTemplate() *schema.Resource {
 				Default:     0,
 				Description: "One of 0,1,2,3,4,5",
 			},
-			"extra_vars": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The extra variables to associate with the job template.",
-				StateFunc:   utils.Normalize,
-			},
+			"extra_vars":    utils.VariablesSchema("The extra variables to associate with the job template, in YAML or JSON format."),
+			"variables_map": utils.VariablesMapSchema("The variables of the job template as a map, exclusive with `extra_vars`. Values holding JSON, such as numbers, booleans or `jsonencode` results, keep their type.", "extra_vars"),
 			"job_tags": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -269,13 +266,18 @@ func resourceJobTemplate() *schema.Resource {
 				},
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: organizationNaturalKeyImporter("job template", listJobTemplateIDs),
 	}
 }
 
 func resourceJobTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if done, diags := adoptExisting(ctx, d, m, "job template", listJobTemplateIDs, map[string]string{
+		"name":         d.Get("name").(string),
+		"organization": strconv.Itoa(d.Get("organization_id").(int)),
+	}, resourceJobTemplateUpdate); done {
+		return diags
+	}
+
 	client := m.(*awx.AWX)
 	result, err := client.JobTemplateService.CreateJobTemplate(map[string]interface{}{
 		"name":                                d.Get("name").(string),
@@ -289,7 +291,7 @@ func resourceJobTemplateCreate(ctx context.Context, d *schema.ResourceData, m in
 		"forks":                               d.Get("forks").(int),
 		"limit":                               d.Get("limit").(string),
 		"verbosity":                           d.Get("verbosity").(int),
-		"extra_vars":                          d.Get("extra_vars").(string),
+		"extra_vars":                          utils.Variables(d, "extra_vars", "variables_map"),
 		"job_tags":                            d.Get("job_tags").(string),
 		"force_handlers":                      d.Get("force_handlers").(bool),
 		"skip_tags":                           d.Get("skip_tags").(string),
@@ -361,7 +363,7 @@ func resourceJobTemplateUpdate(ctx context.Context, d *schema.ResourceData, m in
 		"forks":                               d.Get("forks").(int),
 		"limit":                               d.Get("limit").(string),
 		"verbosity":                           d.Get("verbosity").(int),
-		"extra_vars":                          d.Get("extra_vars").(string),
+		"extra_vars":                          utils.Variables(d, "extra_vars", "variables_map"),
 		"job_tags":                            d.Get("job_tags").(string),
 		"force_handlers":                      d.Get("force_handlers").(bool),
 		"skip_tags":                           d.Get("skip_tags").(string),
@@ -415,10 +417,6 @@ func resourceJobTemplateRead(_ context.Context, d *schema.ResourceData, m interf
 	if err != nil {
 		return utils.DiagNotFound(diagJobTemplateTitle, id, err)
 	}
-	if res.ExtraVars != "" {
-		res.ExtraVars = utils.Normalize(res.ExtraVars)
-	}
-
 	_ = setJobTemplateResourceData(d, res)
 	return nil
 }
@@ -479,7 +477,7 @@ func setJobTemplateResourceData(d *schema.ResourceData, r *awx.JobTemplate) *sch
 	if err := d.Set("description", r.Description); err != nil {
 		fmt.Println("Error setting description", err)
 	}
-	if err := d.Set("extra_vars", utils.Normalize(r.ExtraVars)); err != nil {
+	if err := utils.SetVariables(d, "extra_vars", "variables_map", r.ExtraVars); err != nil {
 		fmt.Println("Error setting extra_vars", err)
 	}
 	if err := d.Set("force_handlers", r.ForceHandlers); err != nil {
@@ -2,7 +2,6 @@ package awx
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -32,9 +31,7 @@ func resourceJobTemplateCredentials() *schema.Resource {
 				Description: "The ID of the credential to associate with the job template",
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: associationImporter("job_template_id", "credential_id"),
 	}
 }
 
@@ -45,7 +42,7 @@ func resourceJobTemplateCredentialsCreate(_ context.Context, d *schema.ResourceD
 		return utils.DiagNotFound("JobTemplate Credential", jobTemplateID, err)
 	}
 
-	result, err := client.JobTemplateService.AssociateCredentials(jobTemplateID, map[string]interface{}{
+	_, err := client.JobTemplateService.AssociateCredentials(jobTemplateID, map[string]interface{}{
 		"id": d.Get("credential_id").(int),
 	}, map[string]string{})
 
@@ -53,12 +50,16 @@ func resourceJobTemplateCredentialsCreate(_ context.Context, d *schema.ResourceD
 		return utils.DiagCreate("JobTemplate AssociateCredentials", err)
 	}
 
-	d.SetId(strconv.Itoa(result.ID))
+	d.SetId(utils.CompositeID(jobTemplateID, d.Get("credential_id").(int)))
 	return nil
 }
 
-func resourceJobTemplateCredentialsRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	return nil
+func resourceJobTemplateCredentialsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	return readAssociation(d, "JobTemplate Credential", "job_template_id", "credential_id", func(parentID int, params map[string]string) (bool, error) {
+		res, err := client.JobTemplateService.ListJobTemplateCredentials(parentID, params)
+		return len(res) > 0, err
+	})
 }
 
 func resourceJobTemplateCredentialsDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -2,7 +2,6 @@ package awx
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -32,6 +31,7 @@ func resourceJobTemplateInstanceGroups() *schema.Resource {
 				Description: "The ID of the instance group to associate with the job template",
 			},
 		},
+		Importer: associationImporter("job_template_id", "instance_group_id"),
 	}
 }
 
@@ -42,7 +42,7 @@ func resourceJobTemplateInstanceGroupsCreate(_ context.Context, d *schema.Resour
 		return utils.DiagNotFound("JobTemplate Credential", jobTemplateID, err)
 	}
 
-	result, err := client.JobTemplateService.AssociateInstanceGroups(jobTemplateID, map[string]interface{}{
+	_, err := client.JobTemplateService.AssociateInstanceGroups(jobTemplateID, map[string]interface{}{
 		"id": d.Get("instance_group_id").(int),
 	}, map[string]string{})
 
@@ -50,12 +50,16 @@ func resourceJobTemplateInstanceGroupsCreate(_ context.Context, d *schema.Resour
 		return utils.DiagCreate("JobTemplate AssociateInstanceGroups", err)
 	}
 
-	d.SetId(strconv.Itoa(result.ID))
+	d.SetId(utils.CompositeID(jobTemplateID, d.Get("instance_group_id").(int)))
 	return nil
 }
 
-func resourceJobTemplateInstanceGroupsRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	return nil
+func resourceJobTemplateInstanceGroupsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	return readAssociation(d, "JobTemplate InstanceGroup", "job_template_id", "instance_group_id", func(parentID int, params map[string]string) (bool, error) {
+		res, _, err := client.JobTemplateService.ListInstanceGroups(parentID, params)
+		return len(res) > 0, err
+	})
 }
 
 func resourceJobTemplateInstanceGroupsDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
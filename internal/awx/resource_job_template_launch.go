@@ -2,13 +2,15 @@ package awx
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
+	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
 	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
@@ -18,23 +20,27 @@ const diagJobTemplateLaunchTitle = "Job Template Launch"
 //nolint:funlen
 func resourceJobTemplateLaunch() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Resource `awx_job_template_launch` manages job template launch.",
+		Description: "Resource `awx_job_template_launch` manages job template launch. A change of its arguments " +
+			"launches a new job, replacing the resource unless `launch_on_update` is set.",
 		CreateContext: resourceJobTemplateLaunchCreate,
 		ReadContext:   resourceJobRead,
+		UpdateContext: resourceJobTemplateLaunchUpdate,
 		DeleteContext: resourceJobDelete,
+		CustomizeDiff: customdiff.All(
+			customizeDiffLaunchPrompts("job template", "job_template_id", jobTemplateLaunchPrompts, jobTemplateLaunchOptions),
+			customizeDiffJobTemplateLaunchRelaunch,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"job_template_id": {
 				Type:        schema.TypeInt,
 				Required:    true,
 				Description: "Job template ID",
-				ForceNew:    true,
 			},
 			"limit": {
 				Type:        schema.TypeString,
 				Required:    false,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "List of comma delimited hosts to limit job execution. Required ask_limit_on_launch set on job_template.",
 			},
 			"inventory_id": {
@@ -43,58 +49,268 @@ func resourceJobTemplateLaunch() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 				Description: "Override Inventory ID. Required ask_inventory_on_launch set on job_template.",
-				ForceNew:    true,
 			},
 			"extra_vars": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Override job template variables. YAML or JSON values are supported.",
+				ValidateFunc:     utils.ValidateVariables,
+				DiffSuppressFunc: utils.SuppressEquivalentVariables,
+			},
+			"job_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"run", "check"}, false),
+				Description:  "Override the job type, `run` or `check`. Required ask_job_type_on_launch set on job_template.",
+			},
+			"job_tags": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Override the comma delimited tags to run. Required ask_tags_on_launch set on job_template.",
+			},
+			"skip_tags": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Override the comma delimited tags to skip. Required ask_skip_tags_on_launch set on job_template.",
+			},
+			"verbosity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 5),
+				Description:  "Override the verbosity, from 0 (normal) to 5 (WinRM debug). Required ask_verbosity_on_launch set on job_template.",
+			},
+			"credential_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Override the credentials of the job. Required ask_credential_on_launch set on job_template.",
+			},
+			"execution_environment_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Override the execution environment ID. Required ask_execution_environment_on_launch set on job_template.",
+			},
+			"label_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Override the labels of the job. Required ask_labels_on_launch set on job_template.",
+			},
+			"forks": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Override the number of forks. Required ask_forks_on_launch set on job_template.",
+			},
+			"job_slice_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Override the number of slices the job is split into. Required ask_job_slice_count_on_launch set on job_template.",
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Override the timeout of the job in seconds, 0 for none. Required ask_timeout_on_launch set on job_template.",
+			},
+			"instance_group_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Override the instance groups of the job, in order of preference. Required ask_instance_groups_on_launch set on job_template.",
+			},
+			"diff_mode": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Override whether the job shows the changes made by tasks. Required ask_diff_mode_on_launch set on job_template.",
+			},
+			"scm_branch": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Override job template variables. YAML or JSON values are supported.",
-				ForceNew:    true,
-				StateFunc:   utils.Normalize,
+				Description: "Override the branch, tag or commit of the project to run. Required ask_scm_branch_on_launch set on job_template.",
 			},
 			"wait_for_completion": {
 				Type:        schema.TypeBool,
 				Required:    false,
 				Optional:    true,
 				Default:     false,
-				Description: "Resource creation will wait for job completion.",
-				ForceNew:    true,
+				Description: "Launching a job will wait for its completion, and fail with the job outcome, explanation and URL when the job fails, errors, is canceled or the timeout is reached.",
+			},
+			"relaunch_failed_hosts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description: "With `wait_for_completion`, relaunch a failed job on its failed hosts only, up to this number " +
+					"of times, before reporting the failure. The last relaunch becomes the resource ID, with its host summaries.",
+			},
+			"cancel_on_destroy": cancelOnDestroySchema(),
+			"cancel_on_timeout": cancelOnTimeoutSchema(),
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values which launch a new job when any of them changes, such as the version " +
+					"of what the job deploys.",
 			},
+			"launch_on_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Launch the new job of a change in place instead of replacing the resource, keeping the " +
+					"IDs of the previous jobs in `job_ids`.",
+			},
+			"job_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the jobs launched by the resource, oldest first. The last one is the resource ID.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the job when it was last read, such as `running` or `successful`.",
+			},
+			"started": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the job started, in RFC 3339 format.",
+			},
+			"finished": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the job finished, in RFC 3339 format.",
+			},
+			"elapsed": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The run time of the job in seconds.",
+			},
+			"failed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the job failed.",
+			},
+			"job_explanation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Why the job is in its status, such as the reason of an error.",
+			},
+			"host_summaries": jobHostSummariesSchema(),
+			"artifacts": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The artifacts of the job set with the `set_stats` module, as JSON. Use `jsondecode` to read " +
+					"the values, available once the job has finished, so with `wait_for_completion`.",
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 	}
 }
 
-func statusInstanceState(_ context.Context, svc *awx.JobService, id int) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := svc.GetJob(id, map[string]string{})
-		return output, output.Status, err
+// jobTemplateLaunchPrompts are the prompts of `awx_job_template_launch`.
+//
+//nolint:gochecknoglobals
+var jobTemplateLaunchPrompts = []launchPrompt{
+	{"limit", "limit", "ask_limit_on_launch", func(o *awx.JobTemplateLaunchOptions) bool { return o.AskLimitOnLaunch }},
+	{"inventory_id", "inventory", "ask_inventory_on_launch", func(o *awx.JobTemplateLaunchOptions) bool { return o.AskInventoryOnLaunch }},
+	{"extra_vars", "extra_vars", "ask_variables_on_launch or a survey", func(o *awx.JobTemplateLaunchOptions) bool {
+		return o.AskVariablesOnLaunch || o.SurveyEnabled
+	}},
+	{"job_type", "job_type", "ask_job_type_on_launch", func(o *awx.JobTemplateLaunchOptions) bool { return o.AskJobTypeOnLaunch }},
+	{"job_tags", "job_tags", "ask_tags_on_launch", func(o *awx.JobTemplateLaunchOptions) bool { return o.AskTagsOnLaunch }},
+	{"skip_tags", "skip_tags", "ask_skip_tags_on_launch", func(o *awx.JobTemplateLaunchOptions) bool { return o.AskSkipTagsOnLaunch }},
+	{"verbosity", "verbosity", "ask_verbosity_on_launch", func(o *awx.JobTemplateLaunchOptions) bool { return o.AskVerbosityOnLaunch }},
+	{"credential_ids", "credentials", "ask_credential_on_launch", func(o *awx.JobTemplateLaunchOptions) bool { return o.AskCredentialOnLaunch }},
+	{"execution_environment_id", "execution_environment", "ask_execution_environment_on_launch", func(o *awx.JobTemplateLaunchOptions) bool {
+		return o.AskExecutionEnvironmentOnLaunch
+	}},
+	{"label_ids", "labels", "ask_labels_on_launch", func(o *awx.JobTemplateLaunchOptions) bool { return o.AskLabelsOnLaunch }},
+	{"forks", "forks", "ask_forks_on_launch", func(o *awx.JobTemplateLaunchOptions) bool { return o.AskForksOnLaunch }},
+	{"job_slice_count", "job_slice_count", "ask_job_slice_count_on_launch", func(o *awx.JobTemplateLaunchOptions) bool {
+		return o.AskJobSliceCountOnLaunch
+	}},
+	{"timeout", "timeout", "ask_timeout_on_launch", func(o *awx.JobTemplateLaunchOptions) bool { return o.AskTimeoutOnLaunch }},
+	{"instance_group_ids", "instance_groups", "ask_instance_groups_on_launch", func(o *awx.JobTemplateLaunchOptions) bool {
+		return o.AskInstanceGroupsOnLaunch
+	}},
+	{"diff_mode", "diff_mode", "ask_diff_mode_on_launch", func(o *awx.JobTemplateLaunchOptions) bool { return o.AskDiffModeOnLaunch }},
+	{"scm_branch", "scm_branch", "ask_scm_branch_on_launch", func(o *awx.JobTemplateLaunchOptions) bool { return o.AskScmBranchOnLaunch }},
+}
+
+func jobTemplateLaunchOptions(client *awx.AWX, id int) (*awx.JobTemplateLaunchOptions, error) {
+	return client.JobTemplateService.GetLaunchOptions(id, map[string]string{})
+}
+
+// jobTemplateLaunchKeys returns the attributes which launch a new job when they change.
+func jobTemplateLaunchKeys() []string {
+	keys := []string{"job_template_id", "triggers", "wait_for_completion"}
+	for _, p := range jobTemplateLaunchPrompts {
+		keys = append(keys, p.Attribute)
 	}
+	return keys
 }
 
-func jobTemplateLaunchWait(ctx context.Context, svc *awx.JobService, job *awx.JobLaunch, timeout time.Duration) error {
+// jobResultKeys are the computed attributes of the job results, unknown until a new job is read.
+//
+//nolint:gochecknoglobals
+var jobResultKeys = []string{
+	"job_ids", "status", "started", "finished", "elapsed", "failed", "job_explanation", "host_summaries", "artifacts",
+}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"new", "pending", "waiting", "running"},
-		Target:     []string{"successful"},
-		Refresh:    statusInstanceState(ctx, svc, job.ID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
+// customizeDiffJobTemplateLaunchRelaunch replaces the resource when an argument launching a job
+// changes, or with `launch_on_update` plans the results of the job launched in place.
+func customizeDiffJobTemplateLaunchRelaunch(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	var changed []string
+	for _, k := range jobTemplateLaunchKeys() {
+		if d.HasChange(k) {
+			changed = append(changed, k)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	if !d.Get("launch_on_update").(bool) {
+		for _, k := range changed {
+			if err := d.ForceNew(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, k := range jobResultKeys {
+		if err := d.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	return err
+func resourceJobTemplateLaunchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return jobTemplateLaunch(ctx, d, m, d.Timeout(schema.TimeoutCreate))
 }
 
-// JobTemplateLaunchData provides payload data used by the JobTemplateLaunch method
-type JobTemplateLaunchData struct {
-	Limit       string `json:"limit,omitempty"`
-	InventoryID int    `json:"inventory,omitempty"`
-	ExtraVars   string `json:"extra_vars,omitempty"`
+func resourceJobTemplateLaunchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.HasChanges(jobTemplateLaunchKeys()...) {
+		return resourceJobRead(ctx, d, m)
+	}
+	return jobTemplateLaunch(ctx, d, m, d.Timeout(schema.TimeoutUpdate))
 }
 
-func resourceJobTemplateLaunchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+// jobTemplateLaunch launches a job with the configured prompts, recording it as the resource ID
+// and in the job history, then waits for it when `wait_for_completion` is set, relaunching it on
+// its failed hosts up to `relaunch_failed_hosts` times.
+func jobTemplateLaunch(ctx context.Context, d *schema.ResourceData, m interface{}, timeout time.Duration) diag.Diagnostics {
 	client := m.(*awx.AWX)
 
 	jobTemplateID := d.Get("job_template_id").(int)
@@ -102,54 +318,138 @@ func resourceJobTemplateLaunchCreate(ctx context.Context, d *schema.ResourceData
 		return utils.DiagNotFound(diagJobTemplateLaunchTitle, jobTemplateID, err)
 	}
 
-	data := JobTemplateLaunchData{
-		Limit:       d.Get("limit").(string),
-		InventoryID: d.Get("inventory_id").(int),
-		ExtraVars:   d.Get("extra_vars").(string),
-	}
-
-	var iData map[string]interface{}
-	idata, err := json.Marshal(data)
+	options, err := client.JobTemplateService.GetLaunchOptions(jobTemplateID, map[string]string{})
 	if err != nil {
-		return utils.DiagCreate(diagJobTemplateLaunchTitle, err)
+		return utils.DiagFetch(diagJobTemplateLaunchTitle, jobTemplateID, err)
 	}
-	if err := json.Unmarshal(idata, &iData); err != nil {
+	if err := checkLaunchPrompts(d, "job template", jobTemplateID, jobTemplateLaunchPrompts, options); err != nil {
 		return utils.DiagCreate(diagJobTemplateLaunchTitle, err)
 	}
 
-	res, err := client.JobTemplateService.Launch(jobTemplateID, iData, map[string]string{})
+	res, err := client.JobTemplateService.Launch(jobTemplateID, launchData(d, jobTemplateLaunchPrompts), map[string]string{})
 	if err != nil {
 		return utils.DiagCreate(diagJobTemplateLaunchTitle, err)
 	}
 
-	// return resourceJobRead(ctx, d, m)
-	d.SetId(strconv.Itoa(res.ID))
+	// The jobs launched before are kept in the prior state, as the planned history is unknown.
+	history, _ := d.GetChange("job_ids")
+	jobIDs := history.([]interface{})
+	if len(jobIDs) == 0 && d.Id() != "" {
+		previous, diags := utils.StateIDToInt("Launch Job", d)
+		if diags.HasError() {
+			return diags
+		}
+		jobIDs = append(jobIDs, previous)
+	}
+	if jobIDs, err = recordLaunchedJob(d, jobIDs, res.ID); err != nil {
+		return utils.DiagSet("job_ids", res.ID, err)
+	}
 
-	if d.Get("wait_for_completion").(bool) {
-		err = jobTemplateLaunchWait(ctx, client.JobService, res, d.Timeout(schema.TimeoutCreate))
-		if err != nil {
-			return utils.Diagf(
-				"JobTemplate execution failure",
-				"JobTemplateLaunch with ID %d and template ID %d, failed to complete %s", res.ID, d.Get("job_template_id").(int), err.Error(),
-			)
+	if !d.Get("wait_for_completion").(bool) {
+		return resourceJobRead(ctx, d, m)
+	}
+	deadline := time.Now().Add(timeout)
+	jobID := res.ID
+	_, err = client.JobService.WaitForJob(ctx, jobID, &awx.JobWaitOptions{Timeout: time.Until(deadline)})
+	relaunches := 0
+	for ; relaunches < d.Get("relaunch_failed_hosts").(int) && jobOutcome(err) == awx.JobOutcomeFailed; relaunches++ {
+		relaunched, rerr := client.JobService.Relaunch(jobID, &awx.JobRelaunchRequest{Hosts: awx.RelaunchHostsFailed})
+		if rerr != nil {
+			diags := append(diagJobWait("JobTemplate", fmt.Sprintf("template ID %d", jobTemplateID), err, func(jobID int) string {
+				return jobFailureSummary(client, jobID)
+			}), utils.Diagf("Unable to relaunch Job", "Unable to relaunch job %d on its failed hosts, got %s", jobID, rerr)...)
+			return append(diags, resourceJobRead(ctx, d, m)...)
+		}
+		jobID = relaunched.ID
+		if jobIDs, err = recordLaunchedJob(d, jobIDs, jobID); err != nil {
+			return utils.DiagSet("job_ids", jobID, err)
 		}
+		_, err = client.JobService.WaitForJob(ctx, jobID, &awx.JobWaitOptions{Timeout: time.Until(deadline)})
 	}
-	return nil
+	if err != nil {
+		diags := append(diagJobWait("JobTemplate", fmt.Sprintf("template ID %d", jobTemplateID), err, func(jobID int) string {
+			return jobFailureSummary(client, jobID)
+		}), cancelJobOnTimeout(ctx, d, playbookJobCanceler(client), jobID, err)...)
+		return append(diags, resourceJobRead(ctx, d, m)...)
+	}
+
+	diags := resourceJobRead(ctx, d, m)
+	if relaunches > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Job relaunched on failed hosts",
+			Detail: fmt.Sprintf("Job %d of template ID %d succeeded after relaunching the failed hosts %d time(s), jobs %v.",
+				jobID, jobTemplateID, relaunches, jobIDs),
+		})
+	}
+	return diags
 }
 
-func resourceJobRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	return nil
+// recordLaunchedJob makes a launched job the resource ID, appending it to the job history.
+func recordLaunchedJob(d *schema.ResourceData, jobIDs []interface{}, jobID int) ([]interface{}, error) {
+	jobIDs = append(jobIDs, jobID)
+	d.SetId(strconv.Itoa(jobID))
+	return jobIDs, d.Set("job_ids", jobIDs)
 }
 
-func resourceJobDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+// jobOutcome returns the outcome of a job wait which did not succeed, empty otherwise.
+func jobOutcome(err error) awx.JobOutcome {
+	var waitErr *awx.JobWaitError
+	if !errors.As(err, &waitErr) {
+		return ""
+	}
+	return waitErr.Outcome
+}
+
+func resourceJobRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	jobID, diags := utils.StateIDToInt("Read Job", d)
+	if diags.HasError() {
+		return diags
+	}
+
+	job, err := client.JobService.GetJob(jobID, map[string]string{})
+	if awx.IsNotFound(err) {
+		return diagJobPurged("Job", jobID, "Its last known results are kept.")
+	}
+	if err != nil {
+		return utils.DiagNotFound(diagJobTemplateLaunchTitle, jobID, err)
+	}
+	hosts, err := listJobHostSummaries(client, jobID)
+	if awx.IsNotFound(err) {
+		return diagJobPurged("Job", jobID, "Its last known results are kept.")
+	}
+	if err != nil {
+		return utils.DiagFetch("Job Host Summaries", jobID, err)
+	}
+	// Launches imported or created before the job history only know their own job.
+	if len(d.Get("job_ids").([]interface{})) == 0 {
+		if err := d.Set("job_ids", []int{jobID}); err != nil {
+			return utils.DiagSet("job_ids", jobID, err)
+		}
+	}
+	return setJobResultResourceData(d, job, hosts)
+}
+
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*awx.AWX)
 	jobID, diags := utils.StateIDToInt("Delete Job", d)
 	if diags.HasError() {
 		return diags
 	}
-	if _, err := client.JobService.GetJob(jobID, map[string]string{}); err != nil {
+	_, err := client.JobService.GetJob(jobID, map[string]string{})
+	if awx.IsNotFound(err) {
+		d.SetId("")
+		return diagJobPurged("Job", jobID, "It is removed from the state.")
+	}
+	if err != nil {
 		return utils.DiagNotFound(diagJobTemplateLaunchTitle, jobID, err)
 	}
+	if d.Get("cancel_on_destroy").(bool) {
+		if err := cancelJob(ctx, playbookJobCanceler(client), jobID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return utils.Diagf("Unable to cancel Job", "Unable to cancel job %d before destroying it, got %s", jobID, err)
+		}
+	}
 
 	d.SetId("")
 	return nil
@@ -9,7 +9,7 @@ func resourceJobTemplateNotificationTemplateError() *schema.Resource {
 		Description:   "Provides a resource for creating a job template notification template error.",
 		CreateContext: resourceJobTemplateNotificationTemplateCreateForType("error"),
 		DeleteContext: resourceJobTemplateNotificationTemplateDeleteForType("error"),
-		ReadContext:   resourceJobTemplateNotificationTemplateRead,
+		ReadContext:   resourceJobTemplateNotificationTemplateReadForType("error"),
 
 		Schema: map[string]*schema.Schema{
 			"job_template_id": {
@@ -25,5 +25,6 @@ func resourceJobTemplateNotificationTemplateError() *schema.Resource {
 				Description: "The notification template ID to associate with the job template.",
 			},
 		},
+		Importer: associationImporter("job_template_id", "notification_template_id"),
 	}
 }
@@ -2,7 +2,6 @@ package awx
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -53,7 +52,7 @@ func resourceJobTemplateNotificationTemplateCreateForType(typ string) func(ctx c
 			)
 		}
 
-		result, err := associationFunc(jobTemplateID, notificationTemplateID)
+		_, err := associationFunc(jobTemplateID, notificationTemplateID)
 		if err != nil {
 			return utils.Diagf(
 				"Create: JobTemplate not AssociateJobTemplateNotificationTemplates",
@@ -62,13 +61,19 @@ func resourceJobTemplateNotificationTemplateCreateForType(typ string) func(ctx c
 			)
 		}
 
-		d.SetId(strconv.Itoa(result.ID))
+		d.SetId(utils.CompositeID(jobTemplateID, notificationTemplateID))
 		return nil
 	}
 }
 
-func resourceJobTemplateNotificationTemplateRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	return nil
+func resourceJobTemplateNotificationTemplateReadForType(typ string) func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := m.(*awx.AWX)
+		return readAssociation(d, diagJobTemplateNotificationTitle, "job_template_id", "notification_template_id", func(parentID int, params map[string]string) (bool, error) {
+			res, _, err := client.JobTemplateNotificationTemplatesService.ListJobTemplateNotificationTemplates(parentID, typ, params)
+			return len(res) > 0, err
+		})
+	}
 }
 
 func resourceJobTemplateNotificationTemplateDeleteForType(typ string) func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
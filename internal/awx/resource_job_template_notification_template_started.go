@@ -9,7 +9,7 @@ func resourceJobTemplateNotificationTemplateStarted() *schema.Resource {
 		Description:   "Provides a resource for creating a notification template for a job template that will be sent when the job template is started.",
 		CreateContext: resourceJobTemplateNotificationTemplateCreateForType("started"),
 		DeleteContext: resourceJobTemplateNotificationTemplateDeleteForType("started"),
-		ReadContext:   resourceJobTemplateNotificationTemplateRead,
+		ReadContext:   resourceJobTemplateNotificationTemplateReadForType("started"),
 
 		Schema: map[string]*schema.Schema{
 			"job_template_id": {
@@ -25,5 +25,6 @@ func resourceJobTemplateNotificationTemplateStarted() *schema.Resource {
 				Description: "The notification template to associate with the job template.",
 			},
 		},
+		Importer: associationImporter("job_template_id", "notification_template_id"),
 	}
 }
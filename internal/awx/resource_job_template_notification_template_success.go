@@ -9,7 +9,7 @@ func resourceJobTemplateNotificationTemplateSuccess() *schema.Resource {
 		Description:   "A notification template for a job template that is triggered on success.",
 		CreateContext: resourceJobTemplateNotificationTemplateCreateForType("success"),
 		DeleteContext: resourceJobTemplateNotificationTemplateDeleteForType("success"),
-		ReadContext:   resourceJobTemplateNotificationTemplateRead,
+		ReadContext:   resourceJobTemplateNotificationTemplateReadForType("success"),
 
 		Schema: map[string]*schema.Schema{
 			"job_template_id": {
@@ -25,5 +25,6 @@ func resourceJobTemplateNotificationTemplateSuccess() *schema.Resource {
 				Description: "The notification template ID that the notification template is associated with.",
 			},
 		},
+		Importer: associationImporter("job_template_id", "notification_template_id"),
 	}
 }
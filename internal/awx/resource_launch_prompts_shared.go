@@ -0,0 +1,95 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+// launchPrompt is an attribute of a launch resource prompted on launch, with the launch
+// payload field it sets and the ask flag of the template allowing it.
+type launchPrompt struct {
+	Attribute string
+	Field     string
+	Flag      string
+	Allowed   func(*awx.JobTemplateLaunchOptions) bool
+}
+
+// launchConfig is the configuration of a launch resource, during plan or apply.
+type launchConfig interface {
+	Get(key string) interface{}
+	GetRawConfig() cty.Value
+}
+
+// launchPromptConfigured reports whether the prompt attribute is set in the configuration, so
+// that zero values such as a verbosity of 0 are sent while unset prompts keep the defaults of
+// the template.
+func launchPromptConfigured(d launchConfig, attribute string) bool {
+	return !d.GetRawConfig().GetAttr(attribute).IsNull()
+}
+
+// launchData returns the launch payload of the configured prompts.
+func launchData(d launchConfig, prompts []launchPrompt) map[string]interface{} {
+	data := make(map[string]interface{})
+	for _, p := range prompts {
+		if !launchPromptConfigured(d, p.Attribute) {
+			continue
+		}
+		if v, ok := d.Get(p.Attribute).(*schema.Set); ok {
+			data[p.Field] = v.List()
+			continue
+		}
+		data[p.Field] = d.Get(p.Attribute)
+	}
+	return data
+}
+
+// checkLaunchPrompts validates the configured prompts against what the template of kind
+// accepts on launch, as AWX would otherwise ignore them or fail the launch. Prompts unknown
+// at plan time may turn out unset and are checked again before the launch.
+func checkLaunchPrompts(d launchConfig, kind string, templateID int, prompts []launchPrompt, options *awx.JobTemplateLaunchOptions) error {
+	var errs []error
+	for _, p := range prompts {
+		v := d.GetRawConfig().GetAttr(p.Attribute)
+		if v.IsKnown() && !v.IsNull() && !p.Allowed(options) {
+			errs = append(errs, fmt.Errorf("%s %d does not prompt for %s on launch, it requires %s", kind, templateID, p.Attribute, p.Flag))
+		}
+	}
+	if options.InventoryNeededToStart && !launchPromptConfigured(d, "inventory_id") {
+		errs = append(errs, fmt.Errorf("%s %d has no inventory, inventory_id is required", kind, templateID))
+	}
+	if options.CredentialNeededToStart && !launchPromptConfigured(d, "credential_ids") {
+		errs = append(errs, fmt.Errorf("%s %d has no credential, credential_ids is required", kind, templateID))
+	}
+	return errors.Join(errs...)
+}
+
+// customizeDiffLaunchPrompts validates the prompts at plan time against the launch endpoint of
+// the template of kind whose ID is templateKey, so that a launch the template does not allow is
+// rejected before the job runs.
+func customizeDiffLaunchPrompts(kind, templateKey string, prompts []launchPrompt,
+	launchOptions func(client *awx.AWX, id int) (*awx.JobTemplateLaunchOptions, error)) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if !d.NewValueKnown(templateKey) {
+			return nil
+		}
+		keys := []string{templateKey}
+		for _, p := range prompts {
+			keys = append(keys, p.Attribute)
+		}
+		if d.Id() != "" && !d.HasChanges(keys...) {
+			return nil
+		}
+
+		templateID := d.Get(templateKey).(int)
+		options, err := launchOptions(m.(*awx.AWX), templateID)
+		if err != nil {
+			return fmt.Errorf("unable to fetch the launch options of %s %d, got %w", kind, templateID, err)
+		}
+		return checkLaunchPrompts(d, kind, templateID, prompts, options)
+	}
+}
@@ -0,0 +1,132 @@
+package awx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+func TestCheckLaunchPrompts(t *testing.T) {
+	cases := []struct {
+		name    string
+		config  map[string]cty.Value
+		options awx.JobTemplateLaunchOptions
+		errs    []string
+	}{
+		{
+			name:   "no prompt",
+			config: map[string]cty.Value{},
+		},
+		{
+			name:    "prompt allowed",
+			config:  map[string]cty.Value{"limit": cty.StringVal("web1")},
+			options: awx.JobTemplateLaunchOptions{AskLimitOnLaunch: true},
+		},
+		{
+			name:   "prompt without its ask flag",
+			config: map[string]cty.Value{"limit": cty.StringVal("web1"), "verbosity": cty.NumberIntVal(0)},
+			errs: []string{
+				"job template 7 does not prompt for limit on launch, it requires ask_limit_on_launch",
+				"job template 7 does not prompt for verbosity on launch, it requires ask_verbosity_on_launch",
+			},
+		},
+		{
+			name:    "extra_vars allowed by a survey",
+			config:  map[string]cty.Value{"extra_vars": cty.StringVal("release: 1.2.3")},
+			options: awx.JobTemplateLaunchOptions{SurveyEnabled: true},
+		},
+		{
+			name:   "extra_vars without variables nor survey",
+			config: map[string]cty.Value{"extra_vars": cty.StringVal("release: 1.2.3")},
+			errs:   []string{"job template 7 does not prompt for extra_vars on launch, it requires ask_variables_on_launch or a survey"},
+		},
+		{
+			name:   "unknown prompt",
+			config: map[string]cty.Value{"limit": cty.UnknownVal(cty.String)},
+		},
+		{
+			name:    "unknown inventory needed to start",
+			config:  map[string]cty.Value{"inventory_id": cty.UnknownVal(cty.Number)},
+			options: awx.JobTemplateLaunchOptions{AskInventoryOnLaunch: true, InventoryNeededToStart: true},
+		},
+		{
+			name:    "inventory and credential needed to start",
+			config:  map[string]cty.Value{},
+			options: awx.JobTemplateLaunchOptions{InventoryNeededToStart: true, CredentialNeededToStart: true},
+			errs: []string{
+				"job template 7 has no inventory, inventory_id is required",
+				"job template 7 has no credential, credential_ids is required",
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.config["job_template_id"] = cty.NumberIntVal(7)
+			d := testResourceDataConfig(t, resourceJobTemplateLaunch(), tc.config)
+			err := checkLaunchPrompts(d, "job template", 7, jobTemplateLaunchPrompts, &tc.options)
+			checkErrors(t, err, tc.errs)
+		})
+	}
+}
+
+func TestCustomizeDiffLaunchPrompts(t *testing.T) {
+	cases := []struct {
+		name    string
+		config  map[string]cty.Value
+		options awx.JobTemplateLaunchOptions
+		fetched bool
+		errs    []string
+	}{
+		{
+			name:    "prompt without its ask flag",
+			config:  map[string]cty.Value{"job_template_id": cty.NumberIntVal(7), "job_tags": cty.StringVal("deploy")},
+			fetched: true,
+			errs:    []string{"job template 7 does not prompt for job_tags on launch, it requires ask_tags_on_launch"},
+		},
+		{
+			name:    "extra_vars allowed by a survey",
+			config:  map[string]cty.Value{"job_template_id": cty.NumberIntVal(7), "extra_vars": cty.StringVal(`{"release": "1.2.3"}`)},
+			options: awx.JobTemplateLaunchOptions{SurveyEnabled: true},
+			fetched: true,
+		},
+		{
+			name:    "unknown prompt",
+			config:  map[string]cty.Value{"job_template_id": cty.NumberIntVal(7), "scm_branch": cty.UnknownVal(cty.String)},
+			fetched: true,
+		},
+		{
+			name:   "unknown template",
+			config: map[string]cty.Value{"job_template_id": cty.UnknownVal(cty.Number), "limit": cty.StringVal("web1")},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetched := false
+			r := resourceJobTemplateLaunch()
+			r.CustomizeDiff = customizeDiffLaunchPrompts("job template", "job_template_id", jobTemplateLaunchPrompts,
+				func(_ *awx.AWX, id int) (*awx.JobTemplateLaunchOptions, error) {
+					if id != 7 {
+						t.Errorf("Expecting the launch options of job template 7 but got %d", id)
+					}
+					fetched = true
+					return &tc.options, nil
+				})
+
+			coreSchema := r.CoreConfigSchema()
+			config, err := coreSchema.CoerceValue(cty.ObjectVal(tc.config))
+			if err != nil {
+				t.Fatal(err)
+			}
+			// The raw configuration reaches CustomizeDiff through the state, as in a plan.
+			state := &terraform.InstanceState{RawConfig: config}
+			_, err = r.Diff(context.Background(), state, terraform.NewResourceConfigShimmed(config, coreSchema), &awx.AWX{})
+			checkErrors(t, err, tc.errs)
+			if fetched != tc.fetched {
+				t.Fatalf("Expecting the launch options to be fetched %t but got %t", tc.fetched, fetched)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package awx
+
+import (
+	"fmt"
+	"sync"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+// managedCredentialType identifies a credential type managed by AWX by its namespace and
+// kind, as its ID differs between installs.
+type managedCredentialType struct {
+	Namespace string
+	Kind      string
+}
+
+func (t managedCredentialType) String() string {
+	return t.Namespace + "/" + t.Kind
+}
+
+//nolint:gochecknoglobals
+var (
+	machineCredentialType                           = managedCredentialType{Namespace: "ssh", Kind: "ssh"}
+	scmCredentialType                               = managedCredentialType{Namespace: "scm", Kind: "scm"}
+	vaultCredentialType                             = managedCredentialType{Namespace: "vault", Kind: "vault"}
+	gitlabCredentialType                            = managedCredentialType{Namespace: "gitlab_token", Kind: "token"}
+	galaxyCredentialType                            = managedCredentialType{Namespace: "galaxy_api_token", Kind: "galaxy"}
+	azureKeyVaultCredentialType                     = managedCredentialType{Namespace: "azure_kv", Kind: "external"}
+	containerRegistryCredentialType                 = managedCredentialType{Namespace: "registry", Kind: "registry"}
+	gceCredentialType                               = managedCredentialType{Namespace: "gce", Kind: "cloud"}
+	awsCredentialType                               = managedCredentialType{Namespace: "aws", Kind: "cloud"}
+	azureResourceManagerCredentialType              = managedCredentialType{Namespace: "azure_rm", Kind: "cloud"}
+	vmwareCredentialType                            = managedCredentialType{Namespace: "vmware", Kind: "cloud"}
+	openstackCredentialType                         = managedCredentialType{Namespace: "openstack", Kind: "cloud"}
+	kubernetesBearerTokenCredentialType             = managedCredentialType{Namespace: "kubernetes_bearer_token", Kind: "kubernetes"}
+	hashiCorpVaultSecretLookupCredentialType        = managedCredentialType{Namespace: "hashivault_kv", Kind: "external"}
+	hashiCorpVaultSignedSSHCredentialType           = managedCredentialType{Namespace: "hashivault_ssh", Kind: "external"}
+	cyberArkCentralCredentialProviderCredentialType = managedCredentialType{Namespace: "aim", Kind: "external"}
+	thycoticSecretServerCredentialType              = managedCredentialType{Namespace: "thycotic_tss", Kind: "external"}
+)
+
+// managedCredentialTypeKey is the key of a resolved credential type ID, per client.
+type managedCredentialTypeKey struct {
+	client *awx.AWX
+	managedCredentialType
+}
+
+// managedCredentialTypeIDs caches the IDs of the managed credential types, which only change
+// when AWX is reinstalled, so that each is looked up once per run.
+var managedCredentialTypeIDs sync.Map //nolint:gochecknoglobals
+
+// resolveManagedCredentialType returns the ID of the managed credential type t.
+func resolveManagedCredentialType(client *awx.AWX, t managedCredentialType) (int, error) {
+	key := managedCredentialTypeKey{client: client, managedCredentialType: t}
+	if id, ok := managedCredentialTypeIDs.Load(key); ok {
+		return id.(int), nil
+	}
+
+	credType, err := client.CredentialTypeService.GetCredentialTypeByNamespace(t.Namespace, t.Kind, map[string]string{})
+	if err != nil {
+		return 0, fmt.Errorf("unable to resolve credential type %s, got %w", t, err)
+	}
+	managedCredentialTypeIDs.Store(key, credType.ID)
+	return credType.ID, nil
+}
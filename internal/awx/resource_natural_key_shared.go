@@ -0,0 +1,155 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+// naturalKeyLister returns the IDs of the objects matching the given list filters.
+type naturalKeyLister func(client *awx.AWX, params map[string]string) ([]int, error)
+
+// organizationImporter accepts either a numeric ID or the organization name.
+func organizationImporter() *schema.ResourceImporter {
+	return naturalKeyImporter("organization", "<name>", func(client *awx.AWX, names []string) (int, error) {
+		return lookupNaturalKey(client, "organization", names, listOrganizationIDs, map[string]string{"name": names[0]})
+	})
+}
+
+// organizationNaturalKeyImporter accepts either a numeric ID or `<organization>/<name>`.
+func organizationNaturalKeyImporter(kind string, list naturalKeyLister) *schema.ResourceImporter {
+	return naturalKeyImporter(kind, "<organization>/<name>", func(client *awx.AWX, names []string) (int, error) {
+		orgID, err := lookupNaturalKey(client, "organization", names[:1], listOrganizationIDs, map[string]string{"name": names[0]})
+		if err != nil {
+			return 0, err
+		}
+		return lookupNaturalKey(client, kind, names, list, map[string]string{
+			"organization": strconv.Itoa(orgID),
+			"name":         names[1],
+		})
+	})
+}
+
+// inventoryNaturalKeyImporter accepts either a numeric ID or `<organization>/<inventory>/<name>`.
+func inventoryNaturalKeyImporter(kind string, list naturalKeyLister) *schema.ResourceImporter {
+	return naturalKeyImporter(kind, "<organization>/<inventory>/<name>", func(client *awx.AWX, names []string) (int, error) {
+		orgID, err := lookupNaturalKey(client, "organization", names[:1], listOrganizationIDs, map[string]string{"name": names[0]})
+		if err != nil {
+			return 0, err
+		}
+		invID, err := lookupNaturalKey(client, "inventory", names[:2], listInventoryIDs, map[string]string{
+			"organization": strconv.Itoa(orgID),
+			"name":         names[1],
+		})
+		if err != nil {
+			return 0, err
+		}
+		return lookupNaturalKey(client, kind, names, list, map[string]string{
+			"inventory": strconv.Itoa(invID),
+			"name":      names[2],
+		})
+	})
+}
+
+// naturalKeyImporter passes numeric IDs through and otherwise splits the ID into the
+// slash separated names described by format, which resolve turns into the object ID.
+// The last name keeps any extra slashes so that it may contain them.
+func naturalKeyImporter(kind, format string, resolve func(client *awx.AWX, names []string) (int, error)) *schema.ResourceImporter {
+	parts := strings.Count(format, "/") + 1
+	return &schema.ResourceImporter{
+		StateContext: func(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			if _, err := strconv.Atoi(d.Id()); err == nil {
+				return []*schema.ResourceData{d}, nil
+			}
+
+			names := strings.SplitN(d.Id(), "/", parts)
+			if len(names) != parts {
+				return nil, fmt.Errorf("unexpected format of %s ID (%s), expected a numeric ID or %s", kind, d.Id(), format)
+			}
+			id, err := resolve(m.(*awx.AWX), names)
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(strconv.Itoa(id))
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// lookupNaturalKey returns the ID of the single object matching params, failing when
+// the natural key is unknown or ambiguous.
+func lookupNaturalKey(client *awx.AWX, kind string, names []string, list naturalKeyLister, params map[string]string) (int, error) {
+	ids, err := list(client, params)
+	if err != nil {
+		return 0, fmt.Errorf("unable to look up %s %s, got %w", kind, strings.Join(names, "/"), err)
+	}
+	switch len(ids) {
+	case 0:
+		return 0, fmt.Errorf("%s %s not found", kind, strings.Join(names, "/"))
+	case 1:
+		return ids[0], nil
+	}
+	return 0, fmt.Errorf("%s %s is ambiguous, it matches the IDs %v", kind, strings.Join(names, "/"), ids)
+}
+
+func collectIDs[T any](results []T, id func(T) int) []int {
+	ids := make([]int, 0, len(results))
+	for _, r := range results {
+		ids = append(ids, id(r))
+	}
+	return ids
+}
+
+func listOrganizationIDs(client *awx.AWX, params map[string]string) ([]int, error) {
+	res, err := client.OrganizationsService.ListOrganizations(params)
+	return collectIDs(res, func(r *awx.Organization) int { return r.ID }), err
+}
+
+func listProjectIDs(client *awx.AWX, params map[string]string) ([]int, error) {
+	res, _, err := client.ProjectService.ListProjects(params)
+	return collectIDs(res, func(r *awx.Project) int { return r.ID }), err
+}
+
+func listInventoryIDs(client *awx.AWX, params map[string]string) ([]int, error) {
+	res, _, err := client.InventoriesService.ListInventories(params)
+	return collectIDs(res, func(r *awx.Inventory) int { return r.ID }), err
+}
+
+func listHostIDs(client *awx.AWX, params map[string]string) ([]int, error) {
+	res, _, err := client.HostService.ListHosts(params)
+	return collectIDs(res, func(r *awx.Host) int { return r.ID }), err
+}
+
+func listGroupIDs(client *awx.AWX, params map[string]string) ([]int, error) {
+	res, _, err := client.GroupService.ListGroups(params)
+	return collectIDs(res, func(r *awx.Group) int { return r.ID }), err
+}
+
+func listInventorySourceIDs(client *awx.AWX, params map[string]string) ([]int, error) {
+	res, _, err := client.InventorySourcesService.ListInventorySources(params)
+	return collectIDs(res, func(r *awx.InventorySource) int { return r.ID }), err
+}
+
+func listJobTemplateIDs(client *awx.AWX, params map[string]string) ([]int, error) {
+	res, _, err := client.JobTemplateService.ListJobTemplates(params)
+	return collectIDs(res, func(r *awx.JobTemplate) int { return r.ID }), err
+}
+
+func listWorkflowJobTemplateIDs(client *awx.AWX, params map[string]string) ([]int, error) {
+	res, _, err := client.WorkflowJobTemplateService.ListWorkflowJobTemplates(params)
+	return collectIDs(res, func(r *awx.WorkflowJobTemplate) int { return r.ID }), err
+}
+
+func listTeamIDs(client *awx.AWX, params map[string]string) ([]int, error) {
+	res, _, err := client.TeamService.ListTeams(params)
+	return collectIDs(res, func(r *awx.Team) int { return r.ID }), err
+}
+
+func listCredentialIDs(client *awx.AWX, params map[string]string) ([]int, error) {
+	res, err := client.CredentialsService.ListCredentials(params)
+	return collectIDs(res, func(r *awx.Credential) int { return r.ID }), err
+}
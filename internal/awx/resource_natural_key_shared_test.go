@@ -0,0 +1,85 @@
+package awx
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+)
+
+func TestNaturalKeyImporter(t *testing.T) {
+	f := awxtest.NewFakeAWX(1)
+	org := f.Add("organizations", map[string]interface{}{"name": "Default"})
+	other := f.Add("organizations", map[string]interface{}{"name": "Other"})
+	project := f.Add("projects", map[string]interface{}{"name": "playbooks", "organization": org})
+	f.Add("projects", map[string]interface{}{"name": "playbooks", "organization": other})
+	f.Add("projects", map[string]interface{}{"name": "twice", "organization": org})
+	f.Add("projects", map[string]interface{}{"name": "twice", "organization": org})
+	inv := f.Add("inventories", map[string]interface{}{"name": "prod", "organization": org})
+	host := f.Add("hosts", map[string]interface{}{"name": "web/1", "inventory": inv})
+	client := awxtest.NewClient(t, f)
+
+	cases := []struct {
+		name     string
+		resource *schema.Resource
+		importer *schema.ResourceImporter
+		id       string
+		want     string
+		err      string
+	}{
+		{
+			name: "numeric ID", resource: resourceProject(),
+			importer: organizationNaturalKeyImporter("project", listProjectIDs), id: "42", want: "42",
+		},
+		{
+			name: "organization and name", resource: resourceProject(),
+			importer: organizationNaturalKeyImporter("project", listProjectIDs), id: "Default/playbooks", want: strconv.Itoa(project),
+		},
+		{
+			name: "name with slashes", resource: resourceHost(),
+			importer: inventoryNaturalKeyImporter("host", listHostIDs), id: "Default/prod/web/1", want: strconv.Itoa(host),
+		},
+		{
+			name: "organization name", resource: resourceOrganization(),
+			importer: organizationImporter(), id: "Other", want: strconv.Itoa(other),
+		},
+		{
+			name: "not found", resource: resourceProject(),
+			importer: organizationNaturalKeyImporter("project", listProjectIDs), id: "Default/missing", err: "project Default/missing not found",
+		},
+		{
+			name: "unknown organization", resource: resourceProject(),
+			importer: organizationNaturalKeyImporter("project", listProjectIDs), id: "Missing/playbooks", err: "organization Missing not found",
+		},
+		{
+			name: "several matches", resource: resourceProject(),
+			importer: organizationNaturalKeyImporter("project", listProjectIDs), id: "Default/twice", err: "project Default/twice is ambiguous",
+		},
+		{
+			name: "wrongly shaped key", resource: resourceProject(),
+			importer: organizationNaturalKeyImporter("project", listProjectIDs), id: "playbooks", err: "expected a numeric ID or <organization>/<name>",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := tc.resource.TestResourceData()
+			d.SetId(tc.id)
+			res, err := tc.importer.StateContext(context.Background(), d, client)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("Expecting an error containing %q but got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res) != 1 || res[0].Id() != tc.want {
+				t.Fatalf("Expecting the ID %s but got %v", tc.want, res[0].Id())
+			}
+		})
+	}
+}
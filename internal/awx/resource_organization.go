@@ -20,6 +20,7 @@ func resourceOrganization() *schema.Resource {
 		DeleteContext: resourceOrganizationsDelete,
 
 		Schema: map[string]*schema.Schema{
+			"adopt_existing": adoptExistingSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -49,9 +50,7 @@ func resourceOrganization() *schema.Resource {
 				Description: "The default execution environment for jobs run by this organization.",
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: organizationImporter(),
 		//
 		//Timeouts: &schema.ResourceTimeout{
 		//	Create: schema.DefaultTimeout(1 * time.Minute),
@@ -62,6 +61,10 @@ func resourceOrganization() *schema.Resource {
 }
 
 func resourceOrganizationsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if done, diags := adoptExisting(ctx, d, m, "organization", listOrganizationIDs, map[string]string{"name": d.Get("name").(string)}, resourceOrganizationsUpdate); done {
+		return diags
+	}
+
 	client := m.(*awx.AWX)
 	result, err := client.OrganizationsService.CreateOrganization(map[string]interface{}{
 		"name":                d.Get("name").(string),
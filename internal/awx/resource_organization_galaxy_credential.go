@@ -2,7 +2,6 @@ package awx
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -34,6 +33,7 @@ func resourceOrganizationsGalaxyCredentials() *schema.Resource {
 				Description: "The Galaxy credential ID to associate with the organization.",
 			},
 		},
+		Importer: associationImporter("organization_id", "credential_id"),
 	}
 }
 
@@ -44,7 +44,7 @@ func resourceOrganizationsGalaxyCredentialsCreate(_ context.Context, d *schema.R
 		return utils.DiagNotFound(diagOrganizationGalaxyCredentialTitle, orgID, err)
 	}
 
-	result, err := client.OrganizationsService.AssociateGalaxyCredentials(orgID, map[string]interface{}{
+	_, err := client.OrganizationsService.AssociateGalaxyCredentials(orgID, map[string]interface{}{
 		"id": d.Get("credential_id").(int),
 	}, map[string]string{})
 
@@ -52,12 +52,16 @@ func resourceOrganizationsGalaxyCredentialsCreate(_ context.Context, d *schema.R
 		return utils.DiagCreate(diagOrganizationGalaxyCredentialTitle, err)
 	}
 
-	d.SetId(strconv.Itoa(result.ID))
+	d.SetId(utils.CompositeID(orgID, d.Get("credential_id").(int)))
 	return nil
 }
 
-func resourceOrganizationsGalaxyCredentialsRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	return nil
+func resourceOrganizationsGalaxyCredentialsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	return readAssociation(d, diagOrganizationGalaxyCredentialTitle, "organization_id", "credential_id", func(parentID int, params map[string]string) (bool, error) {
+		res, _, err := client.OrganizationsService.ListGalaxyCredentials(parentID, params)
+		return len(res) > 0, err
+	})
 }
 
 func resourceOrganizationsGalaxyCredentialsDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -22,6 +22,7 @@ func resourceProject() *schema.Resource {
 		UpdateContext: resourceProjectUpdate,
 
 		Schema: map[string]*schema.Schema{
+			"adopt_existing": adoptExistingSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -97,9 +98,7 @@ func resourceProject() *schema.Resource {
 				Description: "Allow SCM branch override",
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: organizationNaturalKeyImporter("project", listProjectIDs),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
@@ -110,6 +109,13 @@ func resourceProject() *schema.Resource {
 }
 
 func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if done, diags := adoptExisting(ctx, d, m, "project", listProjectIDs, map[string]string{
+		"name":         d.Get("name").(string),
+		"organization": strconv.Itoa(d.Get("organization_id").(int)),
+	}, resourceProjectUpdate); done {
+		return diags
+	}
+
 	client := m.(*awx.AWX)
 	orgID := d.Get("organization_id").(int)
 	projectName := d.Get("name").(string)
@@ -122,7 +128,7 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 		return utils.DiagFetch(diagProjectTitle, orgID, err)
 	}
 	if len(res.Results) >= 1 {
-		return utils.Diagf("Create: Always exist", "Project with name %s  already exists in the Organization ID %v", projectName, orgID)
+		return utils.Diagf("Create: Always exist", "Project with name %s  already exists in the Organization ID %v, set adopt_existing to take it over", projectName, orgID)
 	}
 	credentials := ""
 	if d.Get("scm_credential_id").(int) > 0 {
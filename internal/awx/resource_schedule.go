@@ -52,12 +52,8 @@ func resourceSchedule() *schema.Resource {
 				Optional:    true,
 				Description: "The ID of the Inventory to be used for the schedule",
 			},
-			"extra_data": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				Description: "Extra data to be pass for the schedule (YAML format)",
-			},
+			"extra_data":    utils.VariablesSchema("Extra data to be pass for the schedule (YAML or JSON format)"),
+			"variables_map": utils.VariablesMapSchema("The extra data of the schedule as a map, exclusive with `extra_data`. Values holding JSON, such as numbers, booleans or `jsonencode` results, keep their type.", "extra_data"),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -76,7 +72,7 @@ func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, m inter
 		"unified_job_template": d.Get("unified_job_template_id").(int),
 		"description":          d.Get("description").(string),
 		"enabled":              d.Get("enabled").(bool),
-		"extra_data":           utils.UnmarshalYAML(d.Get("extra_data").(string)),
+		"extra_data":           utils.VariablesData(d, "extra_data", "variables_map"),
 	}
 	if _, ok := d.GetOk("inventory"); ok {
 		scheduleData["inventory"] = d.Get("inventory").(int)
@@ -115,7 +111,7 @@ func resourceScheduleUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		"unified_job_template": d.Get("unified_job_template_id").(int),
 		"description":          d.Get("description").(string),
 		"enabled":              d.Get("enabled").(bool),
-		"extra_data":           utils.UnmarshalYAML(d.Get("extra_data").(string)),
+		"extra_data":           utils.VariablesData(d, "extra_data", "variables_map"),
 	}
 	if _, ok := d.GetOk("inventory"); ok {
 		payload["inventory"] = d.Get("inventory").(int)
@@ -177,7 +173,7 @@ func setScheduleResourceData(d *schema.ResourceData, r *awx.Schedule) *schema.Re
 	if err := d.Set("inventory", r.Inventory); err != nil {
 		fmt.Println("Error setting inventory", err)
 	}
-	if err := d.Set("extra_data", utils.MarshalYAML(r.ExtraData)); err != nil {
+	if err := utils.SetVariablesData(d, "extra_data", "variables_map", r.ExtraData); err != nil {
 		fmt.Println("Error setting extra_data", err)
 	}
 	d.SetId(strconv.Itoa(r.ID))
@@ -21,6 +21,7 @@ func resourceTeam() *schema.Resource {
 		UpdateContext: resourceTeamUpdate,
 
 		Schema: map[string]*schema.Schema{
+			"adopt_existing": adoptExistingSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -51,9 +52,7 @@ func resourceTeam() *schema.Resource {
 				},
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: organizationNaturalKeyImporter("team", listTeamIDs),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
@@ -64,6 +63,13 @@ func resourceTeam() *schema.Resource {
 }
 
 func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if done, diags := adoptExisting(ctx, d, m, "team", listTeamIDs, map[string]string{
+		"name":         d.Get("name").(string),
+		"organization": strconv.Itoa(d.Get("organization_id").(int)),
+	}, resourceTeamUpdate); done {
+		return diags
+	}
+
 	client := m.(*awx.AWX)
 	orgID := d.Get("organization_id").(int)
 	teamName := d.Get("name").(string)
@@ -75,7 +81,7 @@ func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, m interface
 		return utils.Diagf("Create: Fail to find Team", "Fail to find Team %s Organization ID %v, %s", teamName, orgID, err)
 	}
 	if len(res.Results) >= 1 {
-		return utils.Diagf("Create: Already exist", "Team with name %s  already exists in the Organization ID %v", teamName, orgID)
+		return utils.Diagf("Create: Already exist", "Team with name %s  already exists in the Organization ID %v, set adopt_existing to take it over", teamName, orgID)
 	}
 
 	result, err := client.TeamService.CreateTeam(map[string]interface{}{
@@ -19,8 +19,10 @@ func resourceWorkflowJobTemplate() *schema.Resource {
 		ReadContext:   resourceWorkflowJobTemplateRead,
 		UpdateContext: resourceWorkflowJobTemplateUpdate,
 		DeleteContext: resourceWorkflowJobTemplateDelete,
+		Importer:      organizationNaturalKeyImporter("workflow job template", listWorkflowJobTemplateIDs),
 
 		Schema: map[string]*schema.Schema{
+			"adopt_existing": adoptExistingSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -32,13 +34,8 @@ func resourceWorkflowJobTemplate() *schema.Resource {
 				Default:     "",
 				Description: "Optional description of this workflow job template.",
 			},
-			"variables": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				StateFunc:   utils.Normalize,
-				Description: "Extra variables used by Ansible in YAML or JSON format. (string, default=``)",
-			},
+			"variables":     utils.VariablesSchema("Extra variables used by Ansible in YAML or JSON format. (string, default=``)"),
+			"variables_map": utils.VariablesMapSchema("The variables of the workflow job template as a map, exclusive with `variables`. Values holding JSON, such as numbers, booleans or `jsonencode` results, keep their type.", "variables"),
 			"organization_id": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -106,6 +103,13 @@ func resourceWorkflowJobTemplate() *schema.Resource {
 }
 
 func resourceWorkflowJobTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if done, diags := adoptExisting(ctx, d, m, "workflow job template", listWorkflowJobTemplateIDs, map[string]string{
+		"name":         d.Get("name").(string),
+		"organization": strconv.Itoa(d.Get("organization_id").(int)),
+	}, resourceWorkflowJobTemplateUpdate); done {
+		return diags
+	}
+
 	var diags diag.Diagnostics
 	client := m.(*awx.AWX)
 	awxService := client.WorkflowJobTemplateService
@@ -115,7 +119,7 @@ func resourceWorkflowJobTemplateCreate(ctx context.Context, d *schema.ResourceDa
 		"description":              d.Get("description").(string),
 		"organization":             d.Get("organization_id").(int),
 		"inventory":                utils.AtoiDefault(d.Get("inventory_id").(string), nil),
-		"extra_vars":               d.Get("variables").(string),
+		"extra_vars":               utils.Variables(d, "variables", "variables_map"),
 		"survey_enabled":           d.Get("survey_enabled").(bool),
 		"allow_simultaneous":       d.Get("allow_simultaneous").(bool),
 		"ask_variables_on_launch":  d.Get("ask_variables_on_launch").(bool),
@@ -158,7 +162,7 @@ func resourceWorkflowJobTemplateUpdate(ctx context.Context, d *schema.ResourceDa
 		"description":              d.Get("description").(string),
 		"organization":             d.Get("organization_id").(int),
 		"inventory":                utils.AtoiDefault(d.Get("inventory_id").(string), nil),
-		"extra_vars":               d.Get("variables").(string),
+		"extra_vars":               utils.Variables(d, "variables", "variables_map"),
 		"survey_enabled":           d.Get("survey_enabled").(bool),
 		"allow_simultaneous":       d.Get("allow_simultaneous").(bool),
 		"ask_variables_on_launch":  d.Get("ask_variables_on_launch").(bool),
@@ -250,7 +254,7 @@ func setWorkflowJobTemplateResourceData(d *schema.ResourceData, r *awx.WorkflowJ
 	if err := d.Set("webhook_credential", r.WebhookCredential); err != nil {
 		fmt.Println("Error setting webhook_credential", err)
 	}
-	if err := d.Set("variables", utils.Normalize(r.ExtraVars)); err != nil {
+	if err := utils.SetVariables(d, "variables", "variables_map", r.ExtraVars); err != nil {
 		fmt.Println("Error setting variables", err)
 	}
 
@@ -0,0 +1,370 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
+)
+
+const diagWorkflowJobTemplateLaunchTitle = "Workflow Job Template Launch"
+
+//nolint:funlen
+func resourceWorkflowJobTemplateLaunch() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource `awx_workflow_job_template_launch` manages workflow job template launch. A change of " +
+			"its arguments launches a new workflow job, replacing the resource.",
+		CreateContext: resourceWorkflowJobTemplateLaunchCreate,
+		ReadContext:   resourceWorkflowJobRead,
+		UpdateContext: resourceWorkflowJobRead,
+		DeleteContext: resourceWorkflowJobDelete,
+		CustomizeDiff: customizeDiffLaunchPrompts(
+			"workflow job template", "workflow_job_template_id", workflowJobTemplateLaunchPrompts, workflowJobTemplateLaunchOptions,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"workflow_job_template_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Workflow job template ID",
+			},
+			"limit": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of comma delimited hosts to limit job execution. Required ask_limit_on_launch set on workflow_job_template.",
+			},
+			"inventory_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Override Inventory ID. Required ask_inventory_on_launch set on workflow_job_template.",
+			},
+			"extra_vars": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Description:      "Override workflow job template variables. YAML or JSON values are supported.",
+				ValidateFunc:     utils.ValidateVariables,
+				DiffSuppressFunc: utils.SuppressEquivalentVariables,
+			},
+			"job_tags": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Override the comma delimited tags to run. Required ask_tags_on_launch set on workflow_job_template.",
+			},
+			"skip_tags": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Override the comma delimited tags to skip. Required ask_skip_tags_on_launch set on workflow_job_template.",
+			},
+			"label_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Override the labels of the workflow job. Required ask_labels_on_launch set on workflow_job_template.",
+			},
+			"scm_branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Override the branch, tag or commit of the projects to run. Required ask_scm_branch_on_launch set on workflow_job_template.",
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values which launch a new workflow job when any of them changes, such as the " +
+					"version of what the workflow deploys.",
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+				Description: "Launching a workflow job will wait for its completion, and fail with the workflow outcome, " +
+					"the nodes which failed and the jobs they spawned when the workflow fails, errors, is canceled or " +
+					"the timeout is reached.",
+			},
+			"cancel_on_destroy": cancelOnDestroySchema(),
+			"cancel_on_timeout": cancelOnTimeoutSchema(),
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the workflow job when it was last read, such as `running` or `successful`.",
+			},
+			"started": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the workflow job started, in RFC 3339 format.",
+			},
+			"finished": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the workflow job finished, in RFC 3339 format.",
+			},
+			"elapsed": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The run time of the workflow job in seconds.",
+			},
+			"failed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the workflow job failed.",
+			},
+			"job_explanation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Why the workflow job is in its status, such as the reason of an error.",
+			},
+			"nodes": workflowJobNodesSchema(),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func workflowJobNodesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The nodes of the workflow job with the job each spawned.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The ID of the workflow job node",
+				},
+				"identifier": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The identifier of the workflow job template node the node runs",
+				},
+				"unified_job_template_id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The ID of the template the node runs",
+				},
+				"unified_job_template_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the template the node runs",
+				},
+				"job_id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The ID of the job the node spawned, 0 until it runs",
+				},
+				"job_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of the job the node spawned, such as `job` or `project_update`",
+				},
+				"status": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The status of the job the node spawned",
+				},
+				"failed": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the job the node spawned failed",
+				},
+				"do_not_run": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the node was skipped by the path the workflow took",
+				},
+			},
+		},
+	}
+}
+
+// workflowJobTemplateLaunchPrompts are the prompts of `awx_workflow_job_template_launch`.
+//
+//nolint:gochecknoglobals
+var workflowJobTemplateLaunchPrompts = []launchPrompt{
+	{"limit", "limit", "ask_limit_on_launch", func(o *awx.JobTemplateLaunchOptions) bool { return o.AskLimitOnLaunch }},
+	{"inventory_id", "inventory", "ask_inventory_on_launch", func(o *awx.JobTemplateLaunchOptions) bool { return o.AskInventoryOnLaunch }},
+	{"extra_vars", "extra_vars", "ask_variables_on_launch or a survey", func(o *awx.JobTemplateLaunchOptions) bool {
+		return o.AskVariablesOnLaunch || o.SurveyEnabled
+	}},
+	{"job_tags", "job_tags", "ask_tags_on_launch", func(o *awx.JobTemplateLaunchOptions) bool { return o.AskTagsOnLaunch }},
+	{"skip_tags", "skip_tags", "ask_skip_tags_on_launch", func(o *awx.JobTemplateLaunchOptions) bool { return o.AskSkipTagsOnLaunch }},
+	{"label_ids", "labels", "ask_labels_on_launch", func(o *awx.JobTemplateLaunchOptions) bool { return o.AskLabelsOnLaunch }},
+	{"scm_branch", "scm_branch", "ask_scm_branch_on_launch", func(o *awx.JobTemplateLaunchOptions) bool { return o.AskScmBranchOnLaunch }},
+}
+
+func workflowJobTemplateLaunchOptions(client *awx.AWX, id int) (*awx.JobTemplateLaunchOptions, error) {
+	return client.WorkflowJobTemplateService.GetLaunchOptions(id, map[string]string{})
+}
+
+func resourceWorkflowJobTemplateLaunchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+
+	templateID := d.Get("workflow_job_template_id").(int)
+	if _, err := client.WorkflowJobTemplateService.GetWorkflowJobTemplateByID(templateID, make(map[string]string)); err != nil {
+		return utils.DiagNotFound(diagWorkflowJobTemplateLaunchTitle, templateID, err)
+	}
+
+	options, err := workflowJobTemplateLaunchOptions(client, templateID)
+	if err != nil {
+		return utils.DiagFetch(diagWorkflowJobTemplateLaunchTitle, templateID, err)
+	}
+	if err := checkLaunchPrompts(d, "workflow job template", templateID, workflowJobTemplateLaunchPrompts, options); err != nil {
+		return utils.DiagCreate(diagWorkflowJobTemplateLaunchTitle, err)
+	}
+
+	res, err := client.WorkflowJobTemplateService.Launch(templateID, launchData(d, workflowJobTemplateLaunchPrompts), map[string]string{})
+	if err != nil {
+		return utils.DiagCreate(diagWorkflowJobTemplateLaunchTitle, err)
+	}
+	d.SetId(strconv.Itoa(res.ID))
+
+	if d.Get("wait_for_completion").(bool) {
+		opts := &awx.JobWaitOptions{Timeout: d.Timeout(schema.TimeoutCreate)}
+		if _, err := client.WorkflowJobService.WaitForWorkflowJob(ctx, res.ID, opts); err != nil {
+			diags := append(diagJobWait("WorkflowJobTemplate", fmt.Sprintf("template ID %d", templateID), err, func(jobID int) string {
+				return workflowJobFailureSummary(client, jobID)
+			}), cancelJobOnTimeout(ctx, d, workflowJobCanceler(client), res.ID, err)...)
+			return append(diags, resourceWorkflowJobRead(ctx, d, m)...)
+		}
+	}
+	return resourceWorkflowJobRead(ctx, d, m)
+}
+
+// workflowJobFailureSummary describes the nodes of a workflow job whose job did not succeed,
+// with the job each spawned, and the failing tasks of the playbook jobs among them.
+func workflowJobFailureSummary(client *awx.AWX, workflowJobID int) string {
+	nodes, err := client.WorkflowJobService.ListWorkflowJobNodes(workflowJobID, map[string]string{"order_by": "id"})
+	if err != nil {
+		return fmt.Sprintf("\n\nUnable to fetch the workflow nodes, got %s", err)
+	}
+
+	var b strings.Builder
+	for _, n := range nodes {
+		if n.SummaryFields == nil || n.SummaryFields.Job == nil {
+			continue
+		}
+		job := n.SummaryFields.Job
+		if !job.Failed && job.Status != awx.JobStatusCanceled {
+			continue
+		}
+
+		name := n.Identifier
+		if t := n.SummaryFields.UnifiedJobTemplate; t != nil {
+			name = fmt.Sprintf("%s (%s)", name, t.Name)
+		}
+		fmt.Fprintf(&b, "\n\nNode %s spawned %s %d, which finished as %s", name, job.Type, job.ID, job.Status)
+		if job.Type == "job" {
+			fmt.Fprintf(&b, ": %s%s", client.JobService.JobOutputURL(job.ID), jobFailureSummary(client, job.ID))
+		}
+	}
+	return b.String()
+}
+
+func resourceWorkflowJobRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	id, diags := utils.StateIDToInt("Read Workflow Job", d)
+	if diags.HasError() {
+		return diags
+	}
+
+	job, err := client.WorkflowJobService.GetWorkflowJob(id, map[string]string{})
+	if awx.IsNotFound(err) {
+		return diagJobPurged("Workflow Job", id, "Its last known results are kept.")
+	}
+	if err != nil {
+		return utils.DiagNotFound(diagWorkflowJobTemplateLaunchTitle, id, err)
+	}
+	nodes, err := client.WorkflowJobService.ListWorkflowJobNodes(id, map[string]string{"order_by": "id"})
+	if awx.IsNotFound(err) {
+		return diagJobPurged("Workflow Job", id, "Its last known results are kept.")
+	}
+	if err != nil {
+		return utils.DiagFetch("Workflow Job Nodes", id, err)
+	}
+	return setWorkflowJobResourceData(d, job, nodes)
+}
+
+// setWorkflowJobResourceData sets the computed results of a workflow job: its status, timing
+// and the jobs spawned by its nodes.
+func setWorkflowJobResourceData(d *schema.ResourceData, job *awx.WorkflowJob, nodes []*awx.WorkflowJobNode) diag.Diagnostics {
+	results := make([]map[string]interface{}, 0, len(nodes))
+	for _, n := range nodes {
+		node := map[string]interface{}{
+			"id":                      n.ID,
+			"identifier":              n.Identifier,
+			"unified_job_template_id": n.UnifiedJobTemplate,
+			"job_id":                  n.Job,
+			"do_not_run":              n.DoNotRun,
+		}
+		if n.SummaryFields != nil {
+			if t := n.SummaryFields.UnifiedJobTemplate; t != nil {
+				node["unified_job_template_name"] = t.Name
+			}
+			if j := n.SummaryFields.Job; j != nil {
+				node["job_type"] = j.Type
+				node["status"] = j.Status
+				node["failed"] = j.Failed
+			}
+		}
+		results = append(results, node)
+	}
+
+	for k, v := range map[string]interface{}{
+		"workflow_job_template_id": job.WorkflowJobTemplate,
+		"status":                   job.Status,
+		"started":                  formatJobTime(job.Started),
+		"finished":                 formatJobTime(job.Finished),
+		"elapsed":                  job.Elapsed,
+		"failed":                   job.Failed,
+		"job_explanation":          job.JobExplanation,
+		"nodes":                    results,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return utils.DiagSet(k, job.ID, err)
+		}
+	}
+	return nil
+}
+
+func resourceWorkflowJobDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*awx.AWX)
+	id, diags := utils.StateIDToInt("Delete Workflow Job", d)
+	if diags.HasError() {
+		return diags
+	}
+	_, err := client.WorkflowJobService.GetWorkflowJob(id, map[string]string{})
+	if awx.IsNotFound(err) {
+		d.SetId("")
+		return diagJobPurged("Workflow Job", id, "It is removed from the state.")
+	}
+	if err != nil {
+		return utils.DiagNotFound(diagWorkflowJobTemplateLaunchTitle, id, err)
+	}
+	if d.Get("cancel_on_destroy").(bool) {
+		if err := cancelJob(ctx, workflowJobCanceler(client), id, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return utils.Diagf("Unable to cancel Workflow Job", "Unable to cancel workflow job %d before destroying it, got %s", id, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -22,13 +22,7 @@ func resourceWorkflowJobTemplateNode() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 
-			"extra_data": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				StateFunc:   utils.Normalize,
-				Description: "Extra data for the workflow job template node.",
-			},
+			"extra_data": utils.VariablesSchema("Extra data for the workflow job template node."),
 			"inventory_id": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -115,10 +109,10 @@ func resourceWorkflowJobTemplateNode() *schema.Resource {
 				Description: "Unique identifier for the workflow job template node.",
 			},
 		},
-		//Importer: &schema.ResourceImporter{
-		//	State: schema.ImportStatePassthrough,
-		//},
-		//
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		//Timeouts: &schema.ResourceTimeout{
 		//	Create: schema.DefaultTimeout(1 * time.Minute),
 		//	Update: schema.DefaultTimeout(1 * time.Minute),
@@ -233,7 +227,7 @@ func resourceWorkflowJobTemplateNodeDelete(_ context.Context, d *schema.Resource
 
 func setWorkflowJobTemplateNodeResourceData(d *schema.ResourceData, r *awx.WorkflowJobTemplateNode) *schema.ResourceData {
 
-	if err := d.Set("extra_data", utils.Normalize(r.ExtraData)); err != nil {
+	if err := d.Set("extra_data", r.ExtraData); err != nil {
 		fmt.Println("Error setting extra_data", err)
 	}
 	if err := d.Set("inventory_id", strconv.Itoa(r.Inventory)); err != nil {
@@ -12,10 +12,11 @@ func resourceWorkflowJobTemplateNodeAlways() *schema.Resource {
 	return &schema.Resource{
 		Description:   "This resource allows you to create, read, update, and delete a Workflow Job Template Node Always.",
 		CreateContext: resourceWorkflowJobTemplateNodeAlwaysCreate,
-		ReadContext:   resourceWorkflowJobTemplateNodeRead,
+		ReadContext:   resourceWorkflowJobTemplateNodeStepReadForType("always"),
 		UpdateContext: resourceWorkflowJobTemplateNodeUpdate,
 		DeleteContext: resourceWorkflowJobTemplateNodeDelete,
 		Schema:        workflowJobNodeSchema,
+		Importer:      workflowJobTemplateNodeStepImporter(),
 	}
 }
 func resourceWorkflowJobTemplateNodeAlwaysCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -12,10 +12,11 @@ func resourceWorkflowJobTemplateNodeFailure() *schema.Resource {
 	return &schema.Resource{
 		Description:   "This resource allows you to create, read, update, and delete a Workflow Job Template Node Failure in AWX.",
 		CreateContext: resourceWorkflowJobTemplateNodeFailureCreate,
-		ReadContext:   resourceWorkflowJobTemplateNodeRead,
+		ReadContext:   resourceWorkflowJobTemplateNodeStepReadForType("failure"),
 		UpdateContext: resourceWorkflowJobTemplateNodeUpdate,
 		DeleteContext: resourceWorkflowJobTemplateNodeDelete,
 		Schema:        workflowJobNodeSchema,
+		Importer:      workflowJobTemplateNodeStepImporter(),
 	}
 }
 
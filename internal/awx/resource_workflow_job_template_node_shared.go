@@ -14,13 +14,7 @@ import (
 
 var workflowJobNodeSchema = map[string]*schema.Schema{
 
-	"extra_data": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Default:     "",
-		Description: "",
-		StateFunc:   utils.Normalize,
-	},
+	"extra_data": utils.VariablesSchema("Extra data for the workflow job template node."),
 	"workflow_job_template_node_id": {
 		Type:        schema.TypeInt,
 		Required:    true,
@@ -126,3 +120,59 @@ func createNodeForWorkflowJob(ctx context.Context, awxService *awx.WorkflowJobTe
 	d.SetId(strconv.Itoa(result.ID))
 	return resourceWorkflowJobTemplateNodeRead(ctx, d, m)
 }
+
+// workflowJobTemplateNodeStepImporter imports a child node from a `<workflow_job_template_node_id>:<node_id>`
+// ID. The parent is kept in the state so that Read can check the node is still attached to it.
+// Unlike association resources the ID stays the node ID, which other steps reference as their
+// `workflow_job_template_node_id`.
+func workflowJobTemplateNodeStepImporter() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+			parentID, nodeID, err := utils.ParseCompositeID(d.Id())
+			if err != nil {
+				return nil, err
+			}
+			if err := d.Set("workflow_job_template_node_id", parentID); err != nil {
+				return nil, err
+			}
+			d.SetId(strconv.Itoa(nodeID))
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+func resourceWorkflowJobTemplateNodeStepReadForType(typ string) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := m.(*awx.AWX)
+		id, diags := utils.StateIDToInt("Read WorkflowJobTemplateNode", d)
+		if diags.HasError() {
+			return diags
+		}
+
+		parentID := d.Get("workflow_job_template_node_id").(int)
+		parent, err := client.WorkflowJobTemplateNodeService.GetWorkflowJobTemplateNodeByID(parentID, make(map[string]string))
+		if awx.IsNotFound(err) {
+			log.Printf("[WARN] workflow job template node %d no longer exists, removing its %s node %d from the state", parentID, typ, id)
+			d.SetId("")
+			return nil
+		}
+		if err != nil {
+			return utils.DiagNotFound("workflow job template node", parentID, err)
+		}
+
+		children := map[string][]int{
+			"success": parent.SuccessNodes,
+			"failure": parent.FailureNodes,
+			"always":  parent.AlwaysNodes,
+		}[typ]
+		for _, child := range children {
+			if child == id {
+				return resourceWorkflowJobTemplateNodeRead(ctx, d, m)
+			}
+		}
+
+		log.Printf("[WARN] workflow job template node %d is no longer a %s node of %d, removing it from the state", id, typ, parentID)
+		d.SetId("")
+		return nil
+	}
+}
@@ -0,0 +1,65 @@
+package awx
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+)
+
+func TestWorkflowJobTemplateNodeStepImporter(t *testing.T) {
+	d := resourceWorkflowJobTemplateNodeSuccess().TestResourceData()
+	d.SetId("810:840")
+	if _, err := workflowJobTemplateNodeStepImporter().StateContext(context.Background(), d, nil); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "840" || d.Get("workflow_job_template_node_id") != 810 {
+		t.Fatalf("Expecting node 840 under 810 but got %s under %v", d.Id(), d.Get("workflow_job_template_node_id"))
+	}
+
+	d.SetId("840")
+	if _, err := workflowJobTemplateNodeStepImporter().StateContext(context.Background(), d, nil); err == nil {
+		t.Fatal("Expecting an error for an ID without its parent")
+	}
+}
+
+func TestWorkflowJobTemplateNodeStepRead(t *testing.T) {
+	f := awxtest.NewFakeAWX(1)
+	wfjt := f.Add("workflow_job_templates", map[string]interface{}{"name": "pipeline"})
+	child := f.Add("workflow_job_template_nodes", map[string]interface{}{"identifier": "deploy", "workflow_job_template": wfjt})
+	moved := f.Add("workflow_job_template_nodes", map[string]interface{}{"identifier": "notify", "workflow_job_template": wfjt})
+	parent := f.Add("workflow_job_template_nodes", map[string]interface{}{
+		"identifier": "sync", "workflow_job_template": wfjt,
+		"success_nodes": []int{child}, "always_nodes": []int{moved},
+	})
+	client := awxtest.NewClient(t, f)
+
+	for _, tc := range []struct {
+		name   string
+		parent int
+		node   int
+		want   string
+	}{
+		{name: "success node", parent: parent, node: child, want: strconv.Itoa(child)},
+		{name: "no longer a success node", parent: parent, node: moved, want: ""},
+		{name: "parent gone", parent: 99, node: child, want: ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := resourceWorkflowJobTemplateNodeSuccess().TestResourceData()
+			d.SetId(strconv.Itoa(tc.node))
+			if err := d.Set("workflow_job_template_node_id", tc.parent); err != nil {
+				t.Fatal(err)
+			}
+			if diags := resourceWorkflowJobTemplateNodeStepReadForType("success")(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("Unexpected diagnostics %v", diags)
+			}
+			if d.Id() != tc.want {
+				t.Fatalf("Expecting the ID %q but got %q", tc.want, d.Id())
+			}
+			if tc.want != "" && d.Get("identifier") != "deploy" {
+				t.Fatalf("Expecting the node to be read but got %v", d.Get("identifier"))
+			}
+		})
+	}
+}
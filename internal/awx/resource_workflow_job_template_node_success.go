@@ -12,10 +12,11 @@ func resourceWorkflowJobTemplateNodeSuccess() *schema.Resource {
 	return &schema.Resource{
 		Description:   "This resource allows you to create, update, and delete a Workflow Job Template Node Success.",
 		CreateContext: resourceWorkflowJobTemplateNodeSuccessCreate,
-		ReadContext:   resourceWorkflowJobTemplateNodeRead,
+		ReadContext:   resourceWorkflowJobTemplateNodeStepReadForType("success"),
 		UpdateContext: resourceWorkflowJobTemplateNodeUpdate,
 		DeleteContext: resourceWorkflowJobTemplateNodeDelete,
 		Schema:        workflowJobNodeSchema,
+		Importer:      workflowJobTemplateNodeStepImporter(),
 	}
 }
 
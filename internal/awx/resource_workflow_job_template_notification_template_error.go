@@ -9,7 +9,7 @@ func resourceWorkflowJobTemplateNotificationTemplateError() *schema.Resource {
 		Description:   "Provides a resource for creating a notification template for a workflow job template that will be triggered on error.",
 		CreateContext: resourceWorkflowJobTemplateNotificationTemplateCreateForType("error"),
 		DeleteContext: resourceWorkflowJobTemplateNotificationTemplateDeleteForType("error"),
-		ReadContext:   resourceWorkflowJobTemplateNotificationTemplateRead,
+		ReadContext:   resourceWorkflowJobTemplateNotificationTemplateReadForType("error"),
 
 		Schema: map[string]*schema.Schema{
 			"workflow_job_template_id": {
@@ -25,5 +25,6 @@ func resourceWorkflowJobTemplateNotificationTemplateError() *schema.Resource {
 				Description: "The ID of the notification template to associate with the workflow job template.",
 			},
 		},
+		Importer: associationImporter("workflow_job_template_id", "notification_template_id"),
 	}
 }
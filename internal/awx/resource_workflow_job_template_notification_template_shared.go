@@ -2,7 +2,6 @@ package awx
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -10,6 +9,8 @@ import (
 	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
 )
 
+const diagWorkflowJobTemplateNotificationTitle = "Workflow Job Template - Notification Template"
+
 func getResourceWorkflowJobTemplateNotificationTemplateAssociateFuncForType(client *awx.WorkflowJobTemplateNotificationTemplatesService, typ string) func(workflowJobTemplateID int, notificationTemplateID int) (*awx.NotificationTemplate, error) {
 	switch typ {
 	case "error":
@@ -51,7 +52,7 @@ func resourceWorkflowJobTemplateNotificationTemplateCreateForType(typ string) fu
 			)
 		}
 
-		result, err := associationFunc(wjtID, ntID)
+		_, err := associationFunc(wjtID, ntID)
 		if err != nil {
 			return utils.Diagf(
 				"Create: WorkflowJobTemplate not AssociateWorkflowJobTemplateNotificationTemplates",
@@ -60,13 +61,19 @@ func resourceWorkflowJobTemplateNotificationTemplateCreateForType(typ string) fu
 			)
 		}
 
-		d.SetId(strconv.Itoa(result.ID))
+		d.SetId(utils.CompositeID(wjtID, ntID))
 		return nil
 	}
 }
 
-func resourceWorkflowJobTemplateNotificationTemplateRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	return nil
+func resourceWorkflowJobTemplateNotificationTemplateReadForType(typ string) func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := m.(*awx.AWX)
+		return readAssociation(d, diagWorkflowJobTemplateNotificationTitle, "workflow_job_template_id", "notification_template_id", func(parentID int, params map[string]string) (bool, error) {
+			res, _, err := client.WorkflowJobTemplateNotificationTemplatesService.ListWorkflowJobTemplateNotificationTemplates(parentID, typ, params)
+			return len(res) > 0, err
+		})
+	}
 }
 
 func resourceWorkflowJobTemplateNotificationTemplateDeleteForType(typ string) func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
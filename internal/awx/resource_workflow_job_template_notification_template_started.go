@@ -9,7 +9,7 @@ func resourceWorkflowJobTemplateNotificationTemplateStarted() *schema.Resource {
 		Description:   "Provides a resource for creating a notification template for a workflow job template that will be triggered on started.",
 		CreateContext: resourceWorkflowJobTemplateNotificationTemplateCreateForType("started"),
 		DeleteContext: resourceWorkflowJobTemplateNotificationTemplateDeleteForType("started"),
-		ReadContext:   resourceWorkflowJobTemplateNotificationTemplateRead,
+		ReadContext:   resourceWorkflowJobTemplateNotificationTemplateReadForType("started"),
 
 		Schema: map[string]*schema.Schema{
 			"workflow_job_template_id": {
@@ -25,5 +25,6 @@ func resourceWorkflowJobTemplateNotificationTemplateStarted() *schema.Resource {
 				Description: "The ID of the notification template to associate with the workflow job template.",
 			},
 		},
+		Importer: associationImporter("workflow_job_template_id", "notification_template_id"),
 	}
 }
@@ -57,12 +57,8 @@ func resourceWorkflowJobTemplateSchedule() *schema.Resource {
 				Optional:    true,
 				Description: "Inventory applied as a prompt, assuming job template prompts for inventory (id, default=``)",
 			},
-			"extra_data": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				Description: "Extra data to be pass for the schedule (YAML format)",
-			},
+			"extra_data":    utils.VariablesSchema("Extra data to be pass for the schedule (YAML or JSON format)"),
+			"variables_map": utils.VariablesMapSchema("The extra data of the schedule as a map, exclusive with `extra_data`. Values holding JSON, such as numbers, booleans or `jsonencode` results, keep their type.", "extra_data"),
 		},
 	}
 }
@@ -80,7 +76,7 @@ func resourceWorkflowJobTemplateScheduleCreate(ctx context.Context, d *schema.Re
 		"description": d.Get("description").(string),
 		"enabled":     d.Get("enabled").(bool),
 		"inventory":   utils.AtoiDefault(d.Get("inventory").(string), nil),
-		"extra_data":  utils.UnmarshalYAML(d.Get("extra_data").(string)),
+		"extra_data":  utils.VariablesData(d, "extra_data", "variables_map"),
 	}, map[string]string{})
 	if err != nil {
 		log.Printf("Fail to Create Schedule for WorkflowJobTemplate %d: %v", workflowJobTemplateID, err)
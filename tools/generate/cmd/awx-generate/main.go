@@ -0,0 +1,86 @@
+// Command awx-generate writes Terraform configuration and import blocks for the
+// objects of an existing AWX organization, so it can be brought under management
+// by this provider without recreating anything.
+//
+// Usage:
+//
+//	awx-generate -organization Default -out ./awx
+//
+// The connection uses the same AWX_HOSTNAME, AWX_USERNAME, AWX_PASSWORD and AWX_TOKEN
+// environment variables as the provider. Run `terraform plan` in the output directory
+// afterwards: the import blocks adopt the objects, and any remaining diff shows what
+// the generated configuration could not express.
+package main
+
+import (
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/josh-silvas/terraform-provider-awx/tools/generate"
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+func main() {
+	hostname := flag.String("hostname", envDefault("AWX_HOSTNAME", "http://localhost"), "AWX API hostname")
+	username := flag.String("username", envDefault("AWX_USERNAME", "admin"), "AWX username")
+	password := flag.String("password", envDefault("AWX_PASSWORD", "password"), "AWX password")
+	token := flag.String("token", envDefault("AWX_TOKEN", ""), "AWX OAuth2 token, used instead of username and password when set")
+	insecure := flag.Bool("insecure", false, "disable SSL certificate verification")
+	organization := flag.String("organization", "Default", "name of the organization to generate")
+	out := flag.String("out", ".", "directory the main.tf, variables.tf and imports.tf files are written to")
+	flag.Parse()
+
+	client := http.DefaultClient
+	if *insecure {
+		customTransport := http.DefaultTransport.(*http.Transport).Clone()
+		//nolint:gosec
+		customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+		client.Transport = customTransport
+	}
+
+	var c *awx.AWX
+	var err error
+	if *token != "" {
+		c, err = awx.NewAWXToken(*hostname, *token, client)
+	} else {
+		c, err = awx.NewAWX(*hostname, *username, *password, client)
+	}
+	if err != nil {
+		log.Fatalf("unable to create AWX client: %s", err)
+	}
+
+	result, err := generate.New(c).Generate(*organization)
+	if err != nil {
+		log.Fatalf("unable to generate organization %q: %s", *organization, err)
+	}
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		log.Fatal(err)
+	}
+	files := map[string][]byte{
+		"main.tf":      result.Config,
+		"variables.tf": result.Variables,
+		"imports.tf":   result.Imports,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(*out, name), content, 0o600); err != nil {
+			log.Fatal(err)
+		}
+	}
+	for _, warning := range result.Warnings {
+		log.Printf("warning: %s", warning)
+	}
+	fmt.Printf("Wrote configuration for organization %q to %s\n", *organization, *out)
+}
+
+func envDefault(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,707 @@
+// Package generate renders the objects of an existing AWX organization as Terraform
+// configuration for this provider, along with the Terraform 1.5 `import` blocks
+// needed to adopt them into state without recreating anything.
+package generate
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/josh-silvas/terraform-provider-awx/internal/awx"
+	goawx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"gopkg.in/yaml.v2"
+)
+
+// encryptedValue is what AWX returns in place of secret inputs.
+const encryptedValue = "$encrypted$"
+
+// Result holds the rendered configuration, split so it can be written to separate files.
+type Result struct {
+	// Config holds the `resource` blocks.
+	Config []byte
+	// Variables holds the sensitive `variable` blocks for secrets AWX does not return.
+	Variables []byte
+	// Imports holds an `import` block for every generated resource that supports import.
+	Imports []byte
+	// Warnings describes what the configuration could not express.
+	Warnings []string
+}
+
+// Generator walks an AWX organization through goawx and renders it as HCL.
+type Generator struct {
+	client  *goawx.AWX
+	schemas map[string]*schema.Resource
+
+	config    *hclwrite.File
+	variables *hclwrite.File
+	imports   *hclwrite.File
+
+	// labels tracks the labels already used per resource type.
+	labels map[string]map[string]bool
+	// refs maps the AWX id of every generated object to its label, per resource type.
+	refs map[string]map[int]string
+	// warnings collects what the configuration could not express.
+	warnings []string
+}
+
+// New returns a Generator reading from the given AWX client.
+func New(client *goawx.AWX) *Generator {
+	return &Generator{
+		client:  client,
+		schemas: awx.Provider().ResourcesMap,
+	}
+}
+
+// Generate renders the organization with the given name, its credentials, projects,
+// inventories, templates, workflows, schedules, notification templates and teams.
+func (g *Generator) Generate(organization string) (*Result, error) {
+	g.config = hclwrite.NewEmptyFile()
+	g.variables = hclwrite.NewEmptyFile()
+	g.imports = hclwrite.NewEmptyFile()
+	g.labels = make(map[string]map[string]bool)
+	g.refs = make(map[string]map[int]string)
+	g.warnings = nil
+
+	orgs, err := g.client.OrganizationsService.ListOrganizations(map[string]string{"name": organization})
+	if err != nil {
+		return nil, err
+	}
+	if len(orgs) != 1 {
+		return nil, fmt.Errorf("expected one organization named %q, found %d", organization, len(orgs))
+	}
+	org := orgs[0]
+
+	steps := []func(*goawx.Organization) error{
+		g.organization,
+		g.credentials,
+		g.notificationTemplates,
+		g.projects,
+		g.inventories,
+		g.jobTemplates,
+		g.workflowJobTemplates,
+		g.schedules,
+		g.teams,
+	}
+	for _, step := range steps {
+		if err := step(org); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Result{
+		Config:    hclwrite.Format(g.config.Bytes()),
+		Variables: hclwrite.Format(g.variables.Bytes()),
+		Imports:   hclwrite.Format(g.imports.Bytes()),
+		Warnings:  g.warnings,
+	}, nil
+}
+
+func (g *Generator) organization(org *goawx.Organization) error {
+	g.resource("awx_organization", g.label("awx_organization", org.Name), org.ID, strconv.Itoa(org.ID), []attr{
+		{"name", org.Name},
+		{"description", org.Description},
+		{"max_hosts", org.MaxHosts},
+		{"custom_virtualenv", org.CustomVirtualenv},
+	})
+	return nil
+}
+
+func (g *Generator) credentials(org *goawx.Organization) error {
+	creds, err := g.client.CredentialsService.ListCredentials(map[string]string{"organization": strconv.Itoa(org.ID)})
+	if err != nil {
+		return err
+	}
+
+	for _, cred := range creds {
+		label := g.label("awx_credential", cred.Name)
+
+		// Inputs keep their JSON types, secrets go to the sensitive inputs as variables.
+		inputs := make(map[string]interface{}, len(cred.Inputs))
+		sensitiveInputs := make(map[string]interface{})
+		for _, k := range sortedKeys(cred.Inputs) {
+			if v := cred.Inputs[k]; v == encryptedValue {
+				sensitiveInputs[k] = g.secret("awx_credential", label, k)
+			} else {
+				inputs[k] = v
+			}
+		}
+
+		g.resource("awx_credential", label, cred.ID, strconv.Itoa(cred.ID), []attr{
+			{"name", cred.Name},
+			{"description", cred.Description},
+			{"organization_id", g.ref("awx_organization", org.ID)},
+			{"credential_type_id", cred.CredentialTypeID},
+			{"inputs", jsonEncode(inputs)},
+			{"sensitive_inputs", jsonEncode(sensitiveInputs)},
+		})
+	}
+	return nil
+}
+
+func (g *Generator) notificationTemplates(org *goawx.Organization) error {
+	templates, err := allPages(orgFilter(org), func(p map[string]string) ([]*goawx.NotificationTemplate, interface{}, error) {
+		r, res, err := g.client.NotificationTemplatesService.List(p)
+		return r, res.Next, err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, nt := range templates {
+		label := g.label("awx_notification_template", nt.Name)
+
+		config := make([]attr, 0, len(nt.NotificationConfiguration))
+		for _, k := range sortedKeys(nt.NotificationConfiguration) {
+			v := nt.NotificationConfiguration[k]
+			if v == encryptedValue {
+				config = append(config, attr{k, g.secret("awx_notification_template", label, k)})
+				continue
+			}
+			config = append(config, attr{k, v})
+		}
+
+		body := g.resource("awx_notification_template", label, nt.ID, strconv.Itoa(nt.ID), []attr{
+			{"name", nt.Name},
+			{"description", nt.Description},
+			{"organization_id", g.ref("awx_organization", org.ID)},
+			{"notification_type", nt.NotificationType},
+		})
+		res := g.schemas["awx_notification_template"]
+		nestedBlock(body, res.Schema, "notification_configuration", config)
+		if messages, ok := nt.Messages.(map[string]interface{}); ok {
+			nestedBlock(body, res.Schema, "messages", []attr{
+				{"started", messages["started"]},
+				{"success", messages["success"]},
+				{"error", messages["error"]},
+			})
+		}
+	}
+	return nil
+}
+
+func (g *Generator) projects(org *goawx.Organization) error {
+	projects, err := allPages(orgFilter(org), func(p map[string]string) ([]*goawx.Project, interface{}, error) {
+		r, res, err := g.client.ProjectService.ListProjects(p)
+		return r, res.Next, err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, p := range projects {
+		// AWX picks the local path of SCM based projects itself.
+		localPath := ""
+		if p.ScmType == "" {
+			localPath = p.LocalPath
+		}
+		g.resource("awx_project", g.label("awx_project", p.Name), p.ID, strconv.Itoa(p.ID), []attr{
+			{"name", p.Name},
+			{"description", p.Description},
+			{"organization_id", g.ref("awx_organization", org.ID)},
+			{"scm_type", p.ScmType},
+			{"scm_url", p.ScmURL},
+			{"scm_branch", p.ScmBranch},
+			{"scm_credential_id", g.ref("awx_credential", p.Credential)},
+			{"scm_clean", p.ScmClean},
+			{"scm_delete_on_update", p.ScmDeleteOnUpdate},
+			{"scm_update_on_launch", p.ScmUpdateOnLaunch},
+			{"scm_update_cache_timeout", p.ScmUpdateCacheTimeout},
+			{"allow_override", p.AllowOverride},
+			{"local_path", localPath},
+		})
+	}
+	return nil
+}
+
+func (g *Generator) inventories(org *goawx.Organization) error {
+	inventories, err := allPages(orgFilter(org), func(p map[string]string) ([]*goawx.Inventory, interface{}, error) {
+		r, res, err := g.client.InventoriesService.ListInventories(p)
+		return r, res.Next, err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, inv := range inventories {
+		g.resource("awx_inventory", g.label("awx_inventory", inv.Name), inv.ID, strconv.Itoa(inv.ID), []attr{
+			{"name", inv.Name},
+			{"description", inv.Description},
+			{"organization_id", g.ref("awx_organization", org.ID)},
+			{"kind", inv.Kind},
+			{"host_filter", inv.HostFilter},
+			{"variables", inv.Variables},
+		})
+
+		// Smart inventories only hold a host filter, their hosts belong to other inventories.
+		if inv.Kind != "" {
+			continue
+		}
+		if err := g.inventoryContent(inv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inventoryContent renders the sources, groups and hosts of an inventory. Groups and
+// hosts created by an inventory source are left to the source to manage.
+func (g *Generator) inventoryContent(inv *goawx.Inventory) error {
+	filter := map[string]string{"inventory": strconv.Itoa(inv.ID)}
+
+	sources, err := allPages(filter, func(p map[string]string) ([]*goawx.InventorySource, interface{}, error) {
+		r, res, err := g.client.InventorySourcesService.ListInventorySources(p)
+		return r, res.Next, err
+	})
+	if err != nil {
+		return err
+	}
+	for _, src := range sources {
+		credential, _ := src.Credential.(float64)
+		g.resource("awx_inventory_source", g.label("awx_inventory_source", src.Name), src.ID, strconv.Itoa(src.ID), []attr{
+			{"name", src.Name},
+			{"description", src.Description},
+			{"inventory_id", g.ref("awx_inventory", inv.ID)},
+			{"source", src.Source},
+			{"source_project_id", g.ref("awx_project", src.SourceProject)},
+			{"source_path", src.SourcePath},
+			{"source_vars", src.SourceVars},
+			{"credential_id", g.ref("awx_credential", int(credential))},
+			{"enabled_var", src.EnabledVar},
+			{"enabled_value", src.EnabledValue},
+			{"host_filter", src.HostFilter},
+			{"overwrite", src.Overwrite},
+			{"overwrite_vars", src.OverwriteVars},
+			{"update_on_launch", src.UpdateOnLaunch},
+			{"update_cache_timeout", src.UpdateCacheTimeout},
+			{"verbosity", src.Verbosity},
+		})
+	}
+
+	groups, err := allPages(filter, func(p map[string]string) ([]*goawx.Group, interface{}, error) {
+		r, res, err := g.client.GroupService.ListGroups(p)
+		return r, res.Next, err
+	})
+	if err != nil {
+		return err
+	}
+	for _, grp := range groups {
+		if grp.HasInventorySources {
+			continue
+		}
+		g.resource("awx_inventory_group", g.label("awx_inventory_group", grp.Name), grp.ID, strconv.Itoa(grp.ID), []attr{
+			{"name", grp.Name},
+			{"description", grp.Description},
+			{"inventory_id", g.ref("awx_inventory", inv.ID)},
+			{"variables", grp.Variables},
+		})
+	}
+
+	hosts, err := allPages(filter, func(p map[string]string) ([]*goawx.Host, interface{}, error) {
+		r, res, err := g.client.HostService.ListHosts(p)
+		return r, res.Next, err
+	})
+	if err != nil {
+		return err
+	}
+	for _, host := range hosts {
+		if host.HasInventorySources {
+			continue
+		}
+		groupIDs, err := g.hostGroups(host)
+		if err != nil {
+			return err
+		}
+		g.resource("awx_host", g.label("awx_host", host.Name), host.ID, strconv.Itoa(host.ID), []attr{
+			{"name", host.Name},
+			{"description", host.Description},
+			{"inventory_id", g.ref("awx_inventory", inv.ID)},
+			{"group_ids", groupIDs},
+			{"enabled", host.Enabled},
+			{"instance_id", host.InstanceID},
+			{"variables", host.Variables},
+		})
+	}
+	return nil
+}
+
+// hostGroups returns references to the groups of a host. The summary fields only
+// carry the first few groups, so the groups endpoint is queried when they are truncated.
+func (g *Generator) hostGroups(host *goawx.Host) ([]interface{}, error) {
+	var groups []goawx.Result
+	if host.SummaryFields != nil && host.SummaryFields.Groups != nil {
+		groups = host.SummaryFields.Groups.Results
+		if host.SummaryFields.Groups.Count > len(groups) {
+			all, err := allPages(map[string]string{"hosts": strconv.Itoa(host.ID)}, func(p map[string]string) ([]*goawx.Group, interface{}, error) {
+				r, res, err := g.client.GroupService.ListGroups(p)
+				return r, res.Next, err
+			})
+			if err != nil {
+				return nil, err
+			}
+			groups = make([]goawx.Result, 0, len(all))
+			for _, grp := range all {
+				groups = append(groups, goawx.Result{ID: grp.ID, Name: grp.Name})
+			}
+		}
+	}
+
+	refs := make([]interface{}, 0, len(groups))
+	for _, grp := range groups {
+		refs = append(refs, g.ref("awx_inventory_group", grp.ID))
+	}
+	return refs, nil
+}
+
+func (g *Generator) jobTemplates(org *goawx.Organization) error {
+	templates, err := allPages(orgFilter(org), func(p map[string]string) ([]*goawx.JobTemplate, interface{}, error) {
+		r, res, err := g.client.JobTemplateService.ListJobTemplates(p)
+		return r, res.Next, err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, jt := range templates {
+		credentialIDs, err := g.client.JobTemplateService.ListJobTemplateCredentials(jt.ID, map[string]string{})
+		if err != nil {
+			return err
+		}
+		credentials := make([]interface{}, 0, len(credentialIDs))
+		for _, id := range credentialIDs {
+			credentials = append(credentials, g.ref("awx_credential", id))
+		}
+
+		g.resource("awx_job_template", g.label("awx_job_template", jt.Name), jt.ID, strconv.Itoa(jt.ID), []attr{
+			{"name", jt.Name},
+			{"description", jt.Description},
+			{"organization_id", g.ref("awx_organization", org.ID)},
+			{"job_type", jt.JobType},
+			{"project_id", g.ref("awx_project", jt.Project)},
+			{"playbook", jt.Playbook},
+			{"inventory_id", g.ref("awx_inventory", jt.Inventory)},
+			{"credential_ids", credentials},
+			{"execution_environment", jt.ExecutionEnvironment},
+			{"scm_branch", jt.ScmBranch},
+			{"forks", jt.Forks},
+			{"limit", jt.Limit},
+			{"verbosity", jt.Verbosity},
+			{"extra_vars", jt.ExtraVars},
+			{"job_tags", jt.JobTags},
+			{"skip_tags", jt.SkipTags},
+			{"force_handlers", jt.ForceHandlers},
+			{"start_at_task", jt.StartAtTask},
+			{"timeout", jt.Timeout},
+			{"use_fact_cache", jt.UseFactCache},
+			{"host_config_key", jt.HostConfigKey},
+			{"job_slice_count", jt.JobSliceCount},
+			{"become_enabled", jt.BecomeEnabled},
+			{"diff_mode", jt.DiffMode},
+			{"allow_simultaneous", jt.AllowSimultaneous},
+			{"survey_enabled", jt.SurveyEnabled},
+			{"prevent_instance_group_fallback", jt.PreventInstanceGroupFallback},
+			{"webhook_service", jt.WebhookService},
+			{"ask_scm_branch_on_launch", jt.AskScmBranchOnLaunch},
+			{"ask_diff_mode_on_launch", jt.AskDiffModeOnLaunch},
+			{"ask_variables_on_launch", jt.AskVariablesOnLaunch},
+			{"ask_limit_on_launch", jt.AskLimitOnLaunch},
+			{"ask_tags_on_launch", jt.AskTagsOnLaunch},
+			{"ask_skip_tags_on_launch", jt.AskSkipTagsOnLaunch},
+			{"ask_job_type_on_launch", jt.AskJobTypeOnLaunch},
+			{"ask_verbosity_on_launch", jt.AskVerbosityOnLaunch},
+			{"ask_inventory_on_launch", jt.AskInventoryOnLaunch},
+			{"ask_credential_on_launch", jt.AskCredentialOnLaunch},
+			{"ask_execution_environment_on_launch", jt.AskExecutionEnvironmentOnLaunch},
+			{"ask_labels_on_launch", jt.AskLabelsOnLaunch},
+			{"ask_forks_on_launch", jt.AskForksOnLaunch},
+			{"ask_job_slice_count_on_launch", jt.AskJobSliceCountOnLaunch},
+			{"ask_timeout_on_launch", jt.AskTimeoutOnLaunch},
+			{"ask_instance_groups_on_launch", jt.AskInstanceGroupsOnLaunch},
+		})
+
+		if err := g.jobTemplateNotifications(jt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *Generator) jobTemplateNotifications(jt *goawx.JobTemplate) error {
+	for _, typ := range []string{"started", "success", "error"} {
+		templates, err := allPages(map[string]string{}, func(p map[string]string) ([]*goawx.NotificationTemplate, interface{}, error) {
+			r, res, err := g.client.JobTemplateNotificationTemplatesService.ListJobTemplateNotificationTemplates(jt.ID, typ, p)
+			return r, res.Next, err
+		})
+		if err != nil {
+			return err
+		}
+
+		resourceType := "awx_job_template_notification_template_" + typ
+		for _, nt := range templates {
+			g.resource(resourceType, g.label(resourceType, jt.Name+"_"+nt.Name), nt.ID, fmt.Sprintf("%d:%d", jt.ID, nt.ID), []attr{
+				{"job_template_id", g.ref("awx_job_template", jt.ID)},
+				{"notification_template_id", g.ref("awx_notification_template", nt.ID)},
+			})
+		}
+	}
+	return nil
+}
+
+func (g *Generator) workflowJobTemplates(org *goawx.Organization) error {
+	templates, err := allPages(orgFilter(org), func(p map[string]string) ([]*goawx.WorkflowJobTemplate, interface{}, error) {
+		r, res, err := g.client.WorkflowJobTemplateService.ListWorkflowJobTemplates(p)
+		return r, res.Next, err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, wf := range templates {
+		g.resource("awx_workflow_job_template", g.label("awx_workflow_job_template", wf.Name), wf.ID, strconv.Itoa(wf.ID), []attr{
+			{"name", wf.Name},
+			{"description", wf.Description},
+			{"organization_id", g.ref("awx_organization", org.ID)},
+			{"inventory_id", g.ref("awx_inventory", wf.Inventory)},
+			{"limit", wf.Limit},
+			{"scm_branch", wf.ScmBranch},
+			{"variables", wf.ExtraVars},
+			{"survey_enabled", wf.SurveyEnabled},
+			{"allow_simultaneous", wf.AllowSimultaneous},
+			{"ask_variables_on_launch", wf.AskVariablesOnLaunch},
+			{"ask_inventory_on_launch", wf.AskInventoryOnLaunch},
+			{"ask_scm_branch_on_launch", wf.AskScmBranchOnLaunch},
+			{"ask_limit_on_launch", wf.AskLimitOnLaunch},
+			{"webhook_service", wf.WebhookService},
+		})
+
+		if err := g.workflowNodes(wf); err != nil {
+			return err
+		}
+		if err := g.workflowJobTemplateNotifications(wf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// workflowNodes renders the graph of a workflow job template. Root nodes become
+// `awx_workflow_job_template_node` resources and every other node is attached to its
+// parent of lowest ID through the success, failure or always node resource. These
+// resources create a node under a single parent, so the other edges of a node with
+// several parents are reported as warnings.
+func (g *Generator) workflowNodes(wf *goawx.WorkflowJobTemplate) error {
+	nodes, err := allPages(map[string]string{"workflow_job_template": strconv.Itoa(wf.ID)}, func(p map[string]string) ([]*goawx.WorkflowJobTemplateNode, interface{}, error) {
+		r, res, err := g.client.WorkflowJobTemplateNodeService.ListWorkflowJobTemplateNodes(p)
+		return r, res.Next, err
+	})
+	if err != nil {
+		return err
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+
+	type edge struct {
+		parent int
+		kind   string
+	}
+	identifiers := make(map[int]string, len(nodes))
+	edges := make(map[int][]edge)
+	for _, n := range nodes {
+		identifiers[n.ID] = n.Identifier
+		for _, kind := range []struct {
+			name     string
+			children []int
+		}{{"success", n.SuccessNodes}, {"failure", n.FailureNodes}, {"always", n.AlwaysNodes}} {
+			for _, child := range kind.children {
+				edges[child] = append(edges[child], edge{parent: n.ID, kind: kind.name})
+			}
+		}
+	}
+	parents := make(map[int]edge, len(edges))
+	for _, n := range nodes {
+		e, ok := edges[n.ID]
+		if !ok {
+			continue
+		}
+		parents[n.ID] = e[0]
+		for _, extra := range e[1:] {
+			g.warnings = append(g.warnings, fmt.Sprintf(
+				"workflow job template %q: node %q is generated as a %s node of %q, its %s edge from %q can not be expressed and must be added in AWX",
+				wf.Name, n.Identifier, e[0].kind, identifiers[e[0].parent], extra.kind, identifiers[extra.parent]))
+		}
+	}
+
+	// Emit parents before their children so every reference points backwards.
+	emitted := make(map[int]string)
+	pending := nodes
+	for len(pending) > 0 {
+		var next []*goawx.WorkflowJobTemplateNode
+		for _, n := range pending {
+			resourceType := "awx_workflow_job_template_node"
+			e, hasParent := parents[n.ID]
+			if hasParent {
+				if _, done := emitted[e.parent]; !done {
+					next = append(next, n)
+					continue
+				}
+				resourceType += "_" + e.kind
+			}
+
+			attrs := []attr{
+				{"workflow_job_template_id", g.ref("awx_workflow_job_template", wf.ID)},
+				{"unified_job_template_id", g.refAny(n.UnifiedJobTemplate, "awx_job_template", "awx_workflow_job_template", "awx_project", "awx_inventory_source")},
+				{"identifier", n.Identifier},
+				{"inventory_id", g.ref("awx_inventory", n.Inventory)},
+				{"extra_data", n.ExtraData},
+				{"scm_branch", n.ScmBranch},
+				{"job_type", n.JobType},
+				{"job_tags", n.JobTags},
+				{"skip_tags", n.SkipTags},
+				{"limit", n.Limit},
+				{"verbosity", n.Verbosity},
+				{"all_parents_must_converge", n.AllParentsMustConverge},
+			}
+			importID := strconv.Itoa(n.ID)
+			if hasParent {
+				attrs = append(attrs, attr{"workflow_job_template_node_id", g.ref(emitted[e.parent], e.parent)})
+				importID = fmt.Sprintf("%d:%d", e.parent, n.ID)
+			}
+			g.resource(resourceType, g.label(resourceType, wf.Name+"_"+n.Identifier), n.ID, importID, attrs)
+			emitted[n.ID] = resourceType
+		}
+		if len(next) == len(pending) {
+			return fmt.Errorf("workflow job template %d has a cycle between nodes", wf.ID)
+		}
+		pending = next
+	}
+	return nil
+}
+
+func (g *Generator) workflowJobTemplateNotifications(wf *goawx.WorkflowJobTemplate) error {
+	for _, typ := range []string{"started", "success", "error"} {
+		templates, err := allPages(map[string]string{}, func(p map[string]string) ([]*goawx.NotificationTemplate, interface{}, error) {
+			r, res, err := g.client.WorkflowJobTemplateNotificationTemplatesService.ListWorkflowJobTemplateNotificationTemplates(wf.ID, typ, p)
+			return r, res.Next, err
+		})
+		if err != nil {
+			return err
+		}
+
+		resourceType := "awx_workflow_job_template_notification_template_" + typ
+		for _, nt := range templates {
+			g.resource(resourceType, g.label(resourceType, wf.Name+"_"+nt.Name), nt.ID, fmt.Sprintf("%d:%d", wf.ID, nt.ID), []attr{
+				{"workflow_job_template_id", g.ref("awx_workflow_job_template", wf.ID)},
+				{"notification_template_id", g.ref("awx_notification_template", nt.ID)},
+			})
+		}
+	}
+	return nil
+}
+
+// schedules renders the schedules of every generated project, job template and workflow job template.
+func (g *Generator) schedules(_ *goawx.Organization) error {
+	for _, resourceType := range []string{"awx_project", "awx_job_template", "awx_workflow_job_template"} {
+		for _, id := range sortedIDs(g.refs[resourceType]) {
+			schedules, err := allPages(map[string]string{"unified_job_template": strconv.Itoa(id)}, func(p map[string]string) ([]*goawx.Schedule, interface{}, error) {
+				r, res, err := g.client.ScheduleService.List(p)
+				return r, res.Next, err
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, s := range schedules {
+				extraData := ""
+				if len(s.ExtraData) > 0 {
+					b, err := yaml.Marshal(s.ExtraData)
+					if err != nil {
+						return err
+					}
+					extraData = string(b)
+				}
+
+				g.resource("awx_schedule", g.label("awx_schedule", s.Name), s.ID, strconv.Itoa(s.ID), []attr{
+					{"name", s.Name},
+					{"description", s.Description},
+					{"rrule", s.Rrule},
+					{"enabled", s.Enabled},
+					{"unified_job_template_id", g.ref(resourceType, id)},
+					{"inventory", g.ref("awx_inventory", s.Inventory)},
+					{"extra_data", extraData},
+				})
+			}
+		}
+	}
+	return nil
+}
+
+func (g *Generator) teams(org *goawx.Organization) error {
+	teams, err := allPages(orgFilter(org), func(p map[string]string) ([]*goawx.Team, interface{}, error) {
+		r, res, err := g.client.TeamService.ListTeams(p)
+		return r, res.Next, err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, team := range teams {
+		roles, _, err := g.client.TeamService.ListTeamRoleEntitlements(team.ID, map[string]string{})
+		if err != nil {
+			return err
+		}
+
+		body := g.resource("awx_team", g.label("awx_team", team.Name), team.ID, strconv.Itoa(team.ID), []attr{
+			{"name", team.Name},
+			{"description", team.Description},
+			{"organization_id", g.ref("awx_organization", org.ID)},
+		})
+		for _, role := range roles {
+			nestedBlock(body, g.schemas["awx_team"].Schema, "role_entitlement", []attr{{"role_id", role.ID}})
+		}
+	}
+	return nil
+}
+
+// allPages calls list with increasing page numbers until AWX reports there is no next page.
+func allPages[T any](params map[string]string, list func(map[string]string) ([]*T, interface{}, error)) ([]*T, error) {
+	results := make([]*T, 0)
+	for page := 1; ; page++ {
+		query := map[string]string{"page": strconv.Itoa(page)}
+		for k, v := range params {
+			query[k] = v
+		}
+
+		items, next, err := list(query)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, items...)
+
+		if s, ok := next.(string); !ok || s == "" {
+			return results, nil
+		}
+	}
+}
+
+func orgFilter(org *goawx.Organization) map[string]string {
+	return map[string]string{"organization": strconv.Itoa(org.ID)}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedIDs(m map[int]string) []int {
+	ids := make([]int, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
@@ -0,0 +1,254 @@
+package generate_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdkcty "github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/josh-silvas/terraform-provider-awx/internal/awx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/generate"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files of testdata") //nolint:gochecknoglobals
+
+// seedFakeAWX fills f with an organization owning one object of every generated type, next
+// to an organization which must be left out.
+func seedFakeAWX(f *awxtest.FakeAWX) {
+	org := f.Add("organizations", map[string]interface{}{"name": "Default", "description": "Main", "max_hosts": 0})
+	other := f.Add("organizations", map[string]interface{}{"name": "Other", "description": "", "max_hosts": 0})
+	f.Add("projects", map[string]interface{}{"name": "elsewhere", "organization": other, "scm_type": "git"})
+
+	cred := f.Add("credentials", map[string]interface{}{
+		"name": "ssh", "description": "", "organization": org, "credential_type": 1,
+		"inputs": map[string]interface{}{"username": "root", "password": "$encrypted$", "become_method": "sudo"},
+	})
+	slack := f.Add("notification_templates", map[string]interface{}{
+		"name": "Slack", "description": "", "organization": org, "notification_type": "slack",
+		"notification_configuration": map[string]interface{}{"channels": []string{"#ops"}, "token": "$encrypted$"},
+	})
+	project := f.Add("projects", map[string]interface{}{
+		"name": "playbooks", "description": "", "organization": org, "scm_type": "git",
+		"scm_url": "https://example.com/playbooks.git", "scm_branch": "main", "credential": cred,
+		"scm_update_on_launch": true,
+	})
+
+	inv := f.Add("inventories", map[string]interface{}{
+		"name": "prod", "description": "", "organization": org, "kind": "", "variables": "env: prod",
+	})
+	f.Add("inventories", map[string]interface{}{
+		"name": "web smart", "description": "", "organization": org, "kind": "smart", "host_filter": "name__startswith=web",
+	})
+	f.Add("inventory_sources", map[string]interface{}{
+		"name": "cloud", "description": "", "inventory": inv, "source": "scm", "source_project": project,
+		"source_path": "inventory/cloud.yml", "overwrite": true,
+	})
+	web := f.Add("groups", map[string]interface{}{"name": "web", "description": "", "inventory": inv, "variables": ""})
+	f.Add("groups", map[string]interface{}{"name": "cloud", "inventory": inv, "has_inventory_sources": true})
+	f.Add("hosts", map[string]interface{}{
+		"name": "web1", "description": "", "inventory": inv, "enabled": true, "variables": "",
+		"summary_fields": map[string]interface{}{"groups": map[string]interface{}{
+			"count": 1, "results": []map[string]interface{}{{"id": web, "name": "web"}},
+		}},
+	})
+	f.Add("hosts", map[string]interface{}{"name": "cloud1", "inventory": inv, "has_inventory_sources": true})
+
+	jt := f.Add("job_templates", map[string]interface{}{
+		"name": "deploy", "description": "", "organization": org, "job_type": "run", "project": project,
+		"playbook": "site.yml", "inventory": inv, "verbosity": 1, "ask_limit_on_launch": true,
+		"credentials": []int{cred}, "notification_templates_error": []int{slack},
+	})
+	wfjt := f.Add("workflow_job_templates", map[string]interface{}{
+		"name": "pipeline", "description": "", "organization": org, "notification_templates_success": []int{slack},
+	})
+	// notify has several parents, and two edges from sync.
+	notify := f.Add("workflow_job_template_nodes", map[string]interface{}{
+		"identifier": "notify", "workflow_job_template": wfjt, "unified_job_template": jt,
+		"all_parents_must_converge": true,
+	})
+	rollback := f.Add("workflow_job_template_nodes", map[string]interface{}{
+		"identifier": "rollback", "workflow_job_template": wfjt, "unified_job_template": jt, "job_type": "check",
+		"success_nodes": []int{notify},
+	})
+	f.Add("workflow_job_template_nodes", map[string]interface{}{
+		"identifier": "sync", "workflow_job_template": wfjt, "unified_job_template": project,
+		"failure_nodes": []int{rollback}, "success_nodes": []int{notify}, "always_nodes": []int{notify},
+	})
+
+	f.Add("schedules", map[string]interface{}{
+		"name": "nightly", "description": "", "unified_job_template": jt, "enabled": true,
+		"rrule": "DTSTART:20240101T000000Z RRULE:FREQ=DAILY;INTERVAL=1", "extra_data": map[string]interface{}{"release": "1.2.3"},
+	})
+
+	role := f.Add("roles", map[string]interface{}{"name": "Execute", "description": "May run the job template"})
+	f.Add("teams", map[string]interface{}{"name": "ops", "description": "", "organization": org, "roles": []int{role}})
+}
+
+func TestGenerate(t *testing.T) {
+	f := awxtest.NewFakeAWX(1)
+	seedFakeAWX(f)
+	result, err := generate.New(awxtest.NewClient(t, f)).Generate("Default")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, got := range map[string][]byte{
+		"main.tf":      result.Config,
+		"variables.tf": result.Variables,
+		"imports.tf":   result.Imports,
+		"warnings.txt": []byte(strings.Join(result.Warnings, "\n") + "\n"),
+	} {
+		golden := filepath.Join("testdata", name)
+		if *update {
+			if err := os.WriteFile(golden, got, 0o600); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		want, err := os.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("Unexpected %s, run the test with -update to accept it:\n%s", name, got)
+		}
+	}
+
+	validateGenerated(t, result)
+}
+
+// validateGenerated checks the generated resources against the provider schema, with the
+// references and variables unknown as in a first plan, and that every import block targets
+// a generated resource which supports import.
+func validateGenerated(t *testing.T, result *generate.Result) {
+	t.Helper()
+	provider := awx.Provider()
+
+	config := parseHCL(t, "main.tf", result.Config)
+	variables := make(map[string]cty.Value)
+	for _, block := range parseHCL(t, "variables.tf", result.Variables).Blocks {
+		variables[block.Labels[0]] = cty.UnknownVal(cty.String)
+	}
+
+	resources := make(map[string]map[string]cty.Value)
+	for _, block := range config.Blocks {
+		if resources[block.Labels[0]] == nil {
+			resources[block.Labels[0]] = make(map[string]cty.Value)
+		}
+		resources[block.Labels[0]][block.Labels[1]] = cty.ObjectVal(map[string]cty.Value{"id": cty.UnknownVal(cty.String)})
+	}
+	ctx := &hcl.EvalContext{
+		Variables: map[string]cty.Value{"var": cty.ObjectVal(variables)},
+		Functions: map[string]function.Function{"jsonencode": stdlib.JSONEncodeFunc},
+	}
+	for resourceType, labels := range resources {
+		ctx.Variables[resourceType] = cty.ObjectVal(labels)
+	}
+
+	for _, block := range config.Blocks {
+		resourceType, label := block.Labels[0], block.Labels[1]
+		res, ok := provider.ResourcesMap[resourceType]
+		if !ok {
+			t.Errorf("Unknown resource type of %s.%s", resourceType, label)
+			continue
+		}
+		coreSchema := res.CoreConfigSchema()
+		val, err := coreSchema.CoerceValue(sdkValue(blockValue(t, ctx, block.Body)))
+		if err != nil {
+			t.Errorf("Unexpected configuration of %s.%s: %s", resourceType, label, err)
+			continue
+		}
+		if diags := provider.ValidateResource(resourceType, terraform.NewResourceConfigShimmed(val, coreSchema)); diags.HasError() {
+			t.Errorf("Invalid configuration of %s.%s: %v", resourceType, label, diags)
+		}
+	}
+
+	for _, block := range parseHCL(t, "imports.tf", result.Imports).Blocks {
+		traversal, diags := hcl.AbsTraversalForExpr(block.Body.Attributes["to"].Expr)
+		if diags.HasErrors() || len(traversal) != 2 {
+			t.Errorf("Unexpected import target %v", diags)
+			continue
+		}
+		resourceType := traversal.RootName()
+		label := traversal[1].(hcl.TraverseAttr).Name
+		if _, ok := resources[resourceType][label]; !ok {
+			t.Errorf("Import of %s.%s which is not generated", resourceType, label)
+		}
+		if res := provider.ResourcesMap[resourceType]; res == nil || res.Importer == nil {
+			t.Errorf("Import of %s.%s which does not support import", resourceType, label)
+		}
+	}
+}
+
+func parseHCL(t *testing.T, name string, src []byte) *hclsyntax.Body {
+	t.Helper()
+	file, diags := hclsyntax.ParseConfig(src, name, hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("Unable to parse %s: %s", name, diags)
+	}
+	return file.Body.(*hclsyntax.Body)
+}
+
+// blockValue evaluates the attributes of body and turns its nested blocks into lists of objects.
+func blockValue(t *testing.T, ctx *hcl.EvalContext, body *hclsyntax.Body) cty.Value {
+	t.Helper()
+	attrs := make(map[string]cty.Value)
+	for name, attr := range body.Attributes {
+		v, diags := attr.Expr.Value(ctx)
+		if diags.HasErrors() {
+			t.Fatalf("Unable to evaluate %s: %s", name, diags)
+		}
+		attrs[name] = v
+	}
+	nested := make(map[string][]cty.Value)
+	for _, block := range body.Blocks {
+		nested[block.Type] = append(nested[block.Type], blockValue(t, ctx, block.Body))
+	}
+	for name, blocks := range nested {
+		attrs[name] = cty.TupleVal(blocks)
+	}
+	return cty.ObjectVal(attrs)
+}
+
+// sdkValue converts a value of the cty module used by hcl to the fork used by the plugin SDK.
+func sdkValue(v cty.Value) sdkcty.Value {
+	switch ty := v.Type(); {
+	case !v.IsKnown():
+		return sdkcty.DynamicVal
+	case v.IsNull():
+		return sdkcty.NullVal(sdkcty.DynamicPseudoType)
+	case ty == cty.String:
+		return sdkcty.StringVal(v.AsString())
+	case ty == cty.Number:
+		return sdkcty.NumberVal(v.AsBigFloat())
+	case ty == cty.Bool:
+		return sdkcty.BoolVal(v.True())
+	case ty.IsObjectType() || ty.IsMapType():
+		attrs := make(map[string]sdkcty.Value)
+		for it := v.ElementIterator(); it.Next(); {
+			k, e := it.Element()
+			attrs[k.AsString()] = sdkValue(e)
+		}
+		return sdkcty.ObjectVal(attrs)
+	default:
+		var elems []sdkcty.Value
+		for it := v.ElementIterator(); it.Next(); {
+			_, e := it.Element()
+			elems = append(elems, sdkValue(e))
+		}
+		if len(elems) == 0 {
+			return sdkcty.EmptyTupleVal
+		}
+		return sdkcty.TupleVal(elems)
+	}
+}
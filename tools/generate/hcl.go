@@ -0,0 +1,253 @@
+package generate
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var (
+	labelInvalidChars = regexp.MustCompile(`[^a-z0-9_]+`)
+	identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+)
+
+// attr is a single attribute of a generated block, kept in a slice so the output
+// follows the order chosen by the walker rather than map iteration order.
+type attr struct {
+	name  string
+	value interface{}
+}
+
+// label returns a unique Terraform label for an object of resourceType named name.
+func (g *Generator) label(resourceType, name string) string {
+	base := labelInvalidChars.ReplaceAllString(strings.ToLower(name), "_")
+	base = strings.Trim(base, "_")
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = "r_" + base
+	}
+
+	used, ok := g.labels[resourceType]
+	if !ok {
+		used = make(map[string]bool)
+		g.labels[resourceType] = used
+	}
+
+	label := base
+	for i := 2; used[label]; i++ {
+		label = fmt.Sprintf("%s_%d", base, i)
+	}
+	used[label] = true
+	return label
+}
+
+// ref returns the expression referencing the `id` of the generated resource of
+// resourceType with the given AWX id. When the object was not generated (it lives
+// outside of the organization, or is not supported) the literal id is returned, and
+// nil for an unset relation.
+func (g *Generator) ref(resourceType string, id int) interface{} {
+	if id == 0 {
+		return nil
+	}
+	if label, ok := g.refs[resourceType][id]; ok {
+		return hclwrite.TokensForTraversal(hcl.Traversal{
+			hcl.TraverseRoot{Name: resourceType},
+			hcl.TraverseAttr{Name: label},
+			hcl.TraverseAttr{Name: "id"},
+		})
+	}
+	return id
+}
+
+// refAny is like ref for the unified job template family, whose ids are shared
+// between job templates, workflow job templates, projects and inventory sources.
+func (g *Generator) refAny(id int, resourceTypes ...string) interface{} {
+	for _, resourceType := range resourceTypes {
+		if _, ok := g.refs[resourceType][id]; ok {
+			return g.ref(resourceType, id)
+		}
+	}
+	if id == 0 {
+		return nil
+	}
+	return id
+}
+
+// secret declares a sensitive input variable for a value AWX does not return and
+// returns the expression referencing it.
+func (g *Generator) secret(resourceType, label, field string) hclwrite.Tokens {
+	name := fmt.Sprintf("%s_%s_%s", strings.TrimPrefix(resourceType, "awx_"), label, labelInvalidChars.ReplaceAllString(field, "_"))
+
+	block := g.variables.Body().AppendNewBlock("variable", []string{name})
+	block.Body().SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+	block.Body().SetAttributeValue("description", cty.StringVal(fmt.Sprintf("Value of %q for %s.%s, which AWX does not return.", field, resourceType, label)))
+	block.Body().SetAttributeValue("sensitive", cty.True)
+	g.variables.Body().AppendNewline()
+
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: name},
+	})
+}
+
+// resource appends a resource block of resourceType for the AWX object with the given
+// id, registers it for references and, when the resource supports it, appends the
+// matching import block. Attributes unknown to the resource schema or equal to their
+// schema default are left out.
+func (g *Generator) resource(resourceType, label string, id int, importID string, attrs []attr) *hclwrite.Body {
+	res := g.schemas[resourceType]
+	if _, ok := g.refs[resourceType]; !ok {
+		g.refs[resourceType] = make(map[int]string)
+	}
+	g.refs[resourceType][id] = label
+
+	block := g.config.Body().AppendNewBlock("resource", []string{resourceType, label})
+	setAttributes(block.Body(), res.Schema, attrs)
+	g.config.Body().AppendNewline()
+
+	if res.Importer != nil && importID != "" {
+		imp := g.imports.Body().AppendNewBlock("import", nil)
+		imp.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: resourceType},
+			hcl.TraverseAttr{Name: label},
+		})
+		imp.Body().SetAttributeValue("id", cty.StringVal(importID))
+		g.imports.Body().AppendNewline()
+	}
+
+	return block.Body()
+}
+
+// nestedBlock appends a nested block named name to body, filtered against the block
+// schema of the enclosing resource. Nothing is written when the block is unknown or
+// every attribute would be omitted.
+func nestedBlock(body *hclwrite.Body, parent map[string]*schema.Schema, name string, attrs []attr) {
+	s, ok := parent[name]
+	if !ok {
+		return
+	}
+	elem, ok := s.Elem.(*schema.Resource)
+	if !ok {
+		return
+	}
+
+	kept := make([]attr, 0, len(attrs))
+	for _, a := range attrs {
+		if as, ok := elem.Schema[a.name]; ok && !omit(as, a.value) {
+			kept = append(kept, a)
+		}
+	}
+	if len(kept) == 0 {
+		return
+	}
+	setAttributes(body.AppendNewBlock(name, nil).Body(), elem.Schema, kept)
+}
+
+func setAttributes(body *hclwrite.Body, schemas map[string]*schema.Schema, attrs []attr) {
+	for _, a := range attrs {
+		s, ok := schemas[a.name]
+		if !ok || omit(s, a.value) {
+			continue
+		}
+		body.SetAttributeRaw(a.name, tokens(a.value))
+	}
+}
+
+// omit reports whether the attribute can be left out of the configuration because
+// Terraform would end up with the same value without it.
+func omit(s *schema.Schema, value interface{}) bool {
+	if s.Required {
+		return false
+	}
+	if _, ok := value.(hclwrite.Tokens); ok {
+		return false
+	}
+	if value == nil {
+		return true
+	}
+	if s.Default != nil {
+		return fmt.Sprint(s.Default) == fmt.Sprint(value)
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
+
+func tokens(value interface{}) hclwrite.Tokens {
+	switch v := value.(type) {
+	case hclwrite.Tokens:
+		return v
+	case string:
+		if strings.HasSuffix(v, "\n") && strings.Count(v, "\n") > 1 {
+			return heredoc(v)
+		}
+		return hclwrite.TokensForValue(cty.StringVal(v))
+	case int:
+		return hclwrite.TokensForValue(cty.NumberIntVal(int64(v)))
+	case float64:
+		return hclwrite.TokensForValue(cty.NumberFloatVal(v))
+	case bool:
+		return hclwrite.TokensForValue(cty.BoolVal(v))
+	case []interface{}:
+		elems := make([]hclwrite.Tokens, 0, len(v))
+		for _, e := range v {
+			elems = append(elems, tokens(e))
+		}
+		return hclwrite.TokensForTuple(elems)
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		items := make([]hclwrite.ObjectAttrTokens, 0, len(v))
+		for _, k := range keys {
+			name := hclwrite.TokensForIdentifier(k)
+			if !identifierPattern.MatchString(k) {
+				name = hclwrite.TokensForValue(cty.StringVal(k))
+			}
+			items = append(items, hclwrite.ObjectAttrTokens{Name: name, Value: tokens(v[k])})
+		}
+		return hclwrite.TokensForObject(items)
+	default:
+		return hclwrite.TokensForValue(cty.StringVal(fmt.Sprint(v)))
+	}
+}
+
+// jsonEncode renders a JSON object attribute, such as the inputs of a credential, as a call to
+// `jsonencode` on the object, and nil when it is empty so that it is left out.
+func jsonEncode(object map[string]interface{}) interface{} {
+	if len(object) == 0 {
+		return nil
+	}
+	return hclwrite.TokensForFunctionCall("jsonencode", tokens(object))
+}
+
+// heredoc renders a multi-line string ending with a newline as a heredoc, which
+// keeps YAML variables and pod specs readable in the generated configuration.
+func heredoc(s string) hclwrite.Tokens {
+	delimiter := "EOT"
+	for i := 2; strings.Contains("\n"+s, "\n"+delimiter+"\n"); i++ {
+		delimiter = "EOT" + strconv.Itoa(i)
+	}
+
+	escaped := strings.NewReplacer("${", "$${", "%{", "%%{").Replace(s)
+	return hclwrite.Tokens{
+		{Type: hclsyntax.TokenOHeredoc, Bytes: []byte("<<" + delimiter + "\n")},
+		{Type: hclsyntax.TokenStringLit, Bytes: []byte(escaped)},
+		{Type: hclsyntax.TokenCHeredoc, Bytes: []byte(delimiter)},
+	}
+}
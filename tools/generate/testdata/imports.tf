@@ -0,0 +1,85 @@
+import {
+  to = awx_organization.default
+  id = "1"
+}
+
+import {
+  to = awx_credential.ssh
+  id = "4"
+}
+
+import {
+  to = awx_project.playbooks
+  id = "6"
+}
+
+import {
+  to = awx_inventory.prod
+  id = "7"
+}
+
+import {
+  to = awx_inventory_source.cloud
+  id = "9"
+}
+
+import {
+  to = awx_inventory_group.web
+  id = "10"
+}
+
+import {
+  to = awx_host.web1
+  id = "12"
+}
+
+import {
+  to = awx_inventory.web_smart
+  id = "8"
+}
+
+import {
+  to = awx_job_template.deploy
+  id = "14"
+}
+
+import {
+  to = awx_job_template_notification_template_error.deploy_slack
+  id = "14:5"
+}
+
+import {
+  to = awx_workflow_job_template.pipeline
+  id = "15"
+}
+
+import {
+  to = awx_workflow_job_template_node.pipeline_sync
+  id = "18"
+}
+
+import {
+  to = awx_workflow_job_template_node_failure.pipeline_rollback
+  id = "18:17"
+}
+
+import {
+  to = awx_workflow_job_template_node_success.pipeline_notify
+  id = "17:16"
+}
+
+import {
+  to = awx_workflow_job_template_notification_template_success.pipeline_slack
+  id = "15:5"
+}
+
+import {
+  to = awx_schedule.nightly
+  id = "19"
+}
+
+import {
+  to = awx_team.ops
+  id = "21"
+}
+
@@ -0,0 +1,140 @@
+resource "awx_organization" "default" {
+  name        = "Default"
+  description = "Main"
+}
+
+resource "awx_credential" "ssh" {
+  name               = "ssh"
+  organization_id    = awx_organization.default.id
+  credential_type_id = 1
+  inputs = jsonencode({
+    become_method = "sudo"
+    username      = "root"
+  })
+  sensitive_inputs = jsonencode({
+    password = var.credential_ssh_password
+  })
+}
+
+resource "awx_notification_template" "slack" {
+  name              = "Slack"
+  organization_id   = awx_organization.default.id
+  notification_type = "slack"
+  notification_configuration {
+    channels = ["#ops"]
+    token    = var.notification_template_slack_token
+  }
+}
+
+resource "awx_project" "playbooks" {
+  name                 = "playbooks"
+  organization_id      = awx_organization.default.id
+  scm_type             = "git"
+  scm_url              = "https://example.com/playbooks.git"
+  scm_branch           = "main"
+  scm_credential_id    = awx_credential.ssh.id
+  scm_update_on_launch = true
+}
+
+resource "awx_inventory" "prod" {
+  name            = "prod"
+  organization_id = awx_organization.default.id
+  variables       = "env: prod"
+}
+
+resource "awx_inventory_source" "cloud" {
+  name                 = "cloud"
+  inventory_id         = awx_inventory.prod.id
+  source_project_id    = awx_project.playbooks.id
+  source_path          = "inventory/cloud.yml"
+  overwrite_vars       = false
+  update_on_launch     = false
+  update_cache_timeout = 0
+  verbosity            = 0
+}
+
+resource "awx_inventory_group" "web" {
+  name         = "web"
+  inventory_id = awx_inventory.prod.id
+}
+
+resource "awx_host" "web1" {
+  name         = "web1"
+  inventory_id = awx_inventory.prod.id
+  group_ids    = [awx_inventory_group.web.id]
+  enabled      = true
+}
+
+resource "awx_inventory" "web_smart" {
+  name            = "web smart"
+  organization_id = awx_organization.default.id
+  kind            = "smart"
+  host_filter     = "name__startswith=web"
+}
+
+resource "awx_job_template" "deploy" {
+  name                = "deploy"
+  organization_id     = awx_organization.default.id
+  project_id          = awx_project.playbooks.id
+  playbook            = "site.yml"
+  inventory_id        = awx_inventory.prod.id
+  credential_ids      = [awx_credential.ssh.id]
+  verbosity           = 1
+  ask_limit_on_launch = true
+}
+
+resource "awx_job_template_notification_template_error" "deploy_slack" {
+  job_template_id          = awx_job_template.deploy.id
+  notification_template_id = awx_notification_template.slack.id
+}
+
+resource "awx_workflow_job_template" "pipeline" {
+  name            = "pipeline"
+  organization_id = awx_organization.default.id
+}
+
+resource "awx_workflow_job_template_node" "pipeline_sync" {
+  workflow_job_template_id  = awx_workflow_job_template.pipeline.id
+  unified_job_template_id   = awx_project.playbooks.id
+  identifier                = "sync"
+  job_type                  = ""
+  all_parents_must_converge = false
+}
+
+resource "awx_workflow_job_template_node_failure" "pipeline_rollback" {
+  workflow_job_template_id      = awx_workflow_job_template.pipeline.id
+  unified_job_template_id       = awx_job_template.deploy.id
+  identifier                    = "rollback"
+  job_type                      = "check"
+  all_parents_must_converge     = false
+  workflow_job_template_node_id = awx_workflow_job_template_node.pipeline_sync.id
+}
+
+resource "awx_workflow_job_template_node_success" "pipeline_notify" {
+  workflow_job_template_id      = awx_workflow_job_template.pipeline.id
+  unified_job_template_id       = awx_job_template.deploy.id
+  identifier                    = "notify"
+  job_type                      = ""
+  workflow_job_template_node_id = awx_workflow_job_template_node_failure.pipeline_rollback.id
+}
+
+resource "awx_workflow_job_template_notification_template_success" "pipeline_slack" {
+  workflow_job_template_id = awx_workflow_job_template.pipeline.id
+  notification_template_id = awx_notification_template.slack.id
+}
+
+resource "awx_schedule" "nightly" {
+  name                    = "nightly"
+  rrule                   = "DTSTART:20240101T000000Z RRULE:FREQ=DAILY;INTERVAL=1"
+  unified_job_template_id = awx_job_template.deploy.id
+  extra_data              = "release: 1.2.3\n"
+}
+
+resource "awx_team" "ops" {
+  name            = "ops"
+  organization_id = awx_organization.default.id
+  role_entitlement {
+    role_id = 20
+  }
+}
+
@@ -0,0 +1,12 @@
+variable "credential_ssh_password" {
+  type        = string
+  description = "Value of \"password\" for awx_credential.ssh, which AWX does not return."
+  sensitive   = true
+}
+
+variable "notification_template_slack_token" {
+  type        = string
+  description = "Value of \"token\" for awx_notification_template.slack, which AWX does not return."
+  sensitive   = true
+}
+
@@ -0,0 +1,157 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// AdHocCommandService implements awx ad hoc command apis.
+type AdHocCommandService struct {
+	client *Client
+}
+
+// AdHocCommandEventsResponse represents `AdHocCommandEvents` endpoint response.
+type AdHocCommandEventsResponse struct {
+	Pagination
+	Results []JobEvent `json:"results"`
+}
+
+const adHocCommandAPIEndpoint = "/api/v2/ad_hoc_commands/"
+
+// LaunchAdHocCommand runs a module on the hosts of the inventory given in data.
+func (a *AdHocCommandService) LaunchAdHocCommand(data map[string]interface{}, params map[string]string) (*AdHocCommand, error) {
+	return a.launch(adHocCommandAPIEndpoint, data, params)
+}
+
+// LaunchInventoryAdHocCommand runs a module on the hosts of an inventory.
+func (a *AdHocCommandService) LaunchInventoryAdHocCommand(inventoryID int, data map[string]interface{}, params map[string]string) (*AdHocCommand, error) {
+	return a.launch(fmt.Sprintf("%s%d/ad_hoc_commands/", inventoriesAPIEndpoint, inventoryID), data, params)
+}
+
+func (a *AdHocCommandService) launch(endpoint string, data map[string]interface{}, params map[string]string) (*AdHocCommand, error) {
+	result := new(AdHocCommand)
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetAdHocCommand shows the details of an ad hoc command.
+func (a *AdHocCommandService) GetAdHocCommand(id int, params map[string]string) (*AdHocCommand, error) {
+	result := new(AdHocCommand)
+	endpoint := fmt.Sprintf("%s%d/", adHocCommandAPIEndpoint, id)
+	resp, err := a.client.Requester.GetJSON(endpoint, result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CancelAdHocCommand cancels an ad hoc command.
+func (a *AdHocCommandService) CancelAdHocCommand(id int, data map[string]interface{}, params map[string]string) (*CancelJobResponse, error) {
+	result := new(CancelJobResponse)
+	endpoint := fmt.Sprintf("%s%d/cancel/", adHocCommandAPIEndpoint, id)
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetAdHocCommandEvents lists the events of an ad hoc command, one per host for the module run.
+func (a *AdHocCommandService) GetAdHocCommandEvents(id int, params map[string]string) ([]JobEvent, *AdHocCommandEventsResponse, error) {
+	result := new(AdHocCommandEventsResponse)
+	endpoint := fmt.Sprintf("%s%d/events/", adHocCommandAPIEndpoint, id)
+	resp, err := a.client.Requester.GetJSON(endpoint, result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
+
+// Stdout streams the output of an ad hoc command. The caller must close the returned reader.
+func (a *AdHocCommandService) Stdout(id int, opts *StdoutOptions) (io.ReadCloser, error) {
+	return unifiedJobStdout(a.client, fmt.Sprintf("%s%d/stdout/", adHocCommandAPIEndpoint, id), opts)
+}
+
+// AdHocCommandOutputURL returns the output page of an ad hoc command in the AWX user interface.
+func (a *AdHocCommandService) AdHocCommandOutputURL(id int) string {
+	return outputURL(a.client, "command", id)
+}
+
+// WaitForAdHocCommand polls an ad hoc command until it reaches a terminal status. It returns the
+// ad hoc command when it is successful, and a *JobWaitError with the outcome otherwise.
+func (a *AdHocCommandService) WaitForAdHocCommand(ctx context.Context, id int, opts *JobWaitOptions) (*AdHocCommand, error) {
+	var cmd *AdHocCommand
+	err := waitForUnifiedJob(ctx, id, a.AdHocCommandOutputURL(id), opts, func() (string, string, error) {
+		got, err := a.GetAdHocCommand(id, map[string]string{})
+		if err != nil {
+			return "", "", err
+		}
+		cmd = got
+		return cmd.Status, cmd.JobExplanation, nil
+	})
+	return cmd, err
+}
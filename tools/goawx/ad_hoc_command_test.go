@@ -0,0 +1,95 @@
+package awx_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+func TestAdHocCommandService(t *testing.T) {
+	var launched []map[string]interface{}
+	launch := func(w http.ResponseWriter, r *http.Request) {
+		var data map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Error(err)
+		}
+		launched = append(launched, data)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 20, "status": "pending", "inventory": 3, "module_name": "ping", "credential": null}`)
+	}
+	client := newRouteAWX(t, routes{
+		"POST /api/v2/ad_hoc_commands/":               launch,
+		"POST /api/v2/inventories/3/ad_hoc_commands/": launch,
+		"GET /api/v2/ad_hoc_commands/20/": reply(http.StatusOK,
+			`{"id": 20, "status": "failed", "failed": true, "inventory": 3, "module_name": "ping", "limit": "web*"}`),
+		"POST /api/v2/ad_hoc_commands/20/cancel/": reply(http.StatusAccepted, ""),
+		"GET /api/v2/ad_hoc_commands/20/events/": func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("event"); got != awx.JobEventRunnerOnUnreachable {
+				t.Errorf("Unexpected event filter %q", got)
+			}
+			fmt.Fprint(w, `{"count": 1, "results": [{"id": 1, "event": "runner_on_unreachable", "host_name": "web2",
+				"event_data": {"res": {"msg": "Failed to connect to the host via ssh"}}}]}`)
+		},
+		"GET /api/v2/ad_hoc_commands/20/stdout/": func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "text/plain")
+			fmt.Fprint(w, "web1 | SUCCESS\nweb2 | UNREACHABLE!\n")
+		},
+	})
+
+	cmd, err := client.AdHocCommandService.LaunchAdHocCommand(map[string]interface{}{"inventory": 3, "module_name": "ping"}, map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd.ID != 20 || cmd.Credential != 0 {
+		t.Fatalf("Unexpected ad hoc command %+v", cmd)
+	}
+	if _, err := client.AdHocCommandService.LaunchInventoryAdHocCommand(3, map[string]interface{}{"module_name": "ping"}, map[string]string{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(launched) != 2 || launched[0]["inventory"] != float64(3) || launched[1]["module_name"] != "ping" {
+		t.Fatalf("Unexpected launch payloads %v", launched)
+	}
+
+	cmd, err = client.AdHocCommandService.GetAdHocCommand(20, map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd.Status != awx.JobStatusFailed || cmd.Limit != "web*" {
+		t.Fatalf("Unexpected ad hoc command %+v", cmd)
+	}
+
+	if _, err := client.AdHocCommandService.CancelAdHocCommand(20, map[string]interface{}{}, map[string]string{}); err != nil {
+		t.Fatal(err)
+	}
+
+	events, _, err := client.AdHocCommandService.GetAdHocCommandEvents(20, map[string]string{"event": awx.JobEventRunnerOnUnreachable})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].HostName != "web2" {
+		t.Fatalf("Unexpected ad hoc command events %+v", events)
+	}
+
+	r, err := client.AdHocCommandService.Stdout(20, &awx.StdoutOptions{StartLine: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if b, err := io.ReadAll(r); err != nil || string(b) != "web2 | UNREACHABLE!\n" {
+		t.Fatalf("Unexpected output %q, %v", b, err)
+	}
+
+	_, err = client.AdHocCommandService.WaitForAdHocCommand(context.Background(), 20, &awx.JobWaitOptions{PollInterval: time.Millisecond})
+	var waitErr *awx.JobWaitError
+	if !errors.As(err, &waitErr) || waitErr.Outcome != awx.JobOutcomeFailed || !strings.HasSuffix(waitErr.URL, "/#/jobs/command/20/output") {
+		t.Fatalf("Expecting a failed outcome but got %v", err)
+	}
+}
@@ -0,0 +1,775 @@
+package awx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+const unifiedJobTemplatesAPIEndpoint = "/api/v2/unified_job_templates/"
+
+// encryptedValue is what AWX returns in place of secret credential inputs.
+const encryptedValue = "$encrypted$"
+
+// AssetService exports and imports AWX content in the JSON format of `awx export`
+// and `awx import`, where objects reference each other by natural key instead of id.
+type AssetService struct {
+	client *Client
+}
+
+// NaturalKey identifies an object by name instead of id, with the natural keys of
+// the objects it belongs to nested in it, e.g.
+// {"type": "project", "name": "Demo", "organization": {"type": "organization", "name": "Default"}}.
+type NaturalKey map[string]interface{}
+
+// Asset is a single exported object. It holds the writable fields of the object with
+// related objects replaced by their natural key, its own key under "natural_key" and,
+// for objects owning other objects, those objects under "related".
+type Asset map[string]interface{}
+
+// Assets is the document written by `awx export` and read by `awx import`.
+type Assets struct {
+	Organizations        []Asset `json:"organizations,omitempty"`
+	Credentials          []Asset `json:"credentials,omitempty"`
+	Projects             []Asset `json:"projects,omitempty"`
+	Inventory            []Asset `json:"inventory,omitempty"`
+	JobTemplates         []Asset `json:"job_templates,omitempty"`
+	WorkflowJobTemplates []Asset `json:"workflow_job_templates,omitempty"`
+}
+
+type assetType struct {
+	endpoint string
+	// key lists the fields making up the natural key of the type.
+	key []string
+	// fields lists the writable fields carried by the asset.
+	fields []string
+}
+
+//nolint:gochecknoglobals
+var assetTypes = map[string]assetType{
+	"organization": {
+		endpoint: organizationsAPIEndpoint,
+		key:      []string{"name"},
+		fields:   []string{"name", "description", "max_hosts"},
+	},
+	"credential_type": {
+		endpoint: credentialTypesAPIEndpoint,
+		key:      []string{"name", "kind"},
+		fields:   []string{"name", "description", "kind", "inputs", "injectors"},
+	},
+	"credential": {
+		endpoint: credentialsAPIEndpoint,
+		key:      []string{"organization", "name", "credential_type"},
+		fields:   []string{"name", "description", "organization", "credential_type", "inputs"},
+	},
+	"project": {
+		endpoint: projectsAPIEndpoint,
+		key:      []string{"organization", "name"},
+		fields: []string{
+			"name", "description", "organization", "scm_type", "scm_url", "scm_branch", "scm_refspec", "scm_clean",
+			"scm_track_submodules", "scm_delete_on_update", "credential", "timeout", "scm_update_on_launch",
+			"scm_update_cache_timeout", "allow_override", "local_path",
+		},
+	},
+	"inventory": {
+		endpoint: inventoriesAPIEndpoint,
+		key:      []string{"organization", "name"},
+		fields:   []string{"name", "description", "organization", "kind", "host_filter", "variables"},
+	},
+	"inventory_source": {
+		endpoint: inventorySourcesAPIEndpoint,
+		key:      []string{"inventory", "name"},
+		fields: []string{
+			"name", "description", "inventory", "source", "source_path", "source_vars", "credential", "source_project",
+			"enabled_var", "enabled_value", "host_filter", "overwrite", "overwrite_vars", "update_on_launch",
+			"update_cache_timeout", "timeout", "verbosity",
+		},
+	},
+	"group": {
+		endpoint: groupsAPIEndpoint,
+		key:      []string{"inventory", "name"},
+		fields:   []string{"name", "description", "inventory", "variables"},
+	},
+	"host": {
+		endpoint: hostsAPIEndpoint,
+		key:      []string{"inventory", "name"},
+		fields:   []string{"name", "description", "inventory", "enabled", "instance_id", "variables"},
+	},
+	"job_template": {
+		endpoint: jobTemplateAPIEndpoint,
+		key:      []string{"organization", "name"},
+		fields: []string{
+			"name", "description", "organization", "job_type", "inventory", "project", "playbook", "scm_branch", "forks",
+			"limit", "verbosity", "extra_vars", "job_tags", "force_handlers", "skip_tags", "start_at_task", "timeout",
+			"use_fact_cache", "host_config_key", "ask_scm_branch_on_launch", "ask_diff_mode_on_launch",
+			"ask_variables_on_launch", "ask_limit_on_launch", "ask_tags_on_launch", "ask_skip_tags_on_launch",
+			"ask_job_type_on_launch", "ask_verbosity_on_launch", "ask_inventory_on_launch", "ask_credential_on_launch",
+			"survey_enabled", "become_enabled", "diff_mode", "allow_simultaneous", "job_slice_count", "webhook_service",
+		},
+	},
+	"workflow_job_template": {
+		endpoint: workflowJobTemplateAPIEndpoint,
+		key:      []string{"organization", "name"},
+		fields: []string{
+			"name", "description", "organization", "extra_vars", "survey_enabled", "allow_simultaneous",
+			"ask_variables_on_launch", "inventory", "limit", "scm_branch", "ask_inventory_on_launch",
+			"ask_scm_branch_on_launch", "ask_limit_on_launch", "webhook_service",
+		},
+	},
+	"workflow_job_template_node": {
+		endpoint: workflowJobTemplateNodeAPIEndpoint,
+		key:      []string{"workflow_job_template", "identifier"},
+		fields: []string{
+			"workflow_job_template", "unified_job_template", "identifier", "extra_data", "inventory", "scm_branch",
+			"job_type", "job_tags", "skip_tags", "limit", "diff_mode", "verbosity", "all_parents_must_converge",
+		},
+	},
+}
+
+// assetRefs maps the fields holding the id of a related object to the type of that
+// object. The type of a unified job template is only known once it is fetched.
+//
+//nolint:gochecknoglobals
+var assetRefs = map[string]string{
+	"organization":          "organization",
+	"credential_type":       "credential_type",
+	"credential":            "credential",
+	"inventory":             "inventory",
+	"project":               "project",
+	"source_project":        "project",
+	"workflow_job_template": "workflow_job_template",
+	"unified_job_template":  "",
+}
+
+// workflowNodeEdges lists the node fields holding the ids of the following nodes.
+//
+//nolint:gochecknoglobals
+var workflowNodeEdges = []string{"success_nodes", "failure_nodes", "always_nodes"}
+
+type listAssetsResponse struct {
+	Pagination
+	Results []map[string]interface{} `json:"results"`
+}
+
+// Export returns the organizations matching params, with the credentials, projects,
+// inventories, job templates and workflow job templates they own.
+func (s *AssetService) Export(params map[string]string) (*Assets, error) {
+	c := newAssetSession(s)
+
+	orgs, err := s.list(organizationsAPIEndpoint, params)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := new(Assets)
+	for _, org := range orgs {
+		a, err := c.asset("organization", org)
+		if err != nil {
+			return nil, err
+		}
+		assets.Organizations = append(assets.Organizations, a)
+
+		orgID, _ := intValue(org["id"])
+		filter := map[string]string{"organization": strconv.Itoa(orgID)}
+		for _, typ := range []string{"credential", "project", "inventory", "job_template", "workflow_job_template"} {
+			objects, err := s.list(assetTypes[typ].endpoint, filter)
+			if err != nil {
+				return nil, err
+			}
+			for _, obj := range objects {
+				a, err := c.asset(typ, obj)
+				if err != nil {
+					return nil, err
+				}
+				if err := c.exportRelated(typ, obj, a); err != nil {
+					return nil, err
+				}
+
+				switch typ {
+				case "credential":
+					assets.Credentials = append(assets.Credentials, a)
+				case "project":
+					assets.Projects = append(assets.Projects, a)
+				case "inventory":
+					assets.Inventory = append(assets.Inventory, a)
+				case "job_template":
+					assets.JobTemplates = append(assets.JobTemplates, a)
+				case "workflow_job_template":
+					assets.WorkflowJobTemplates = append(assets.WorkflowJobTemplates, a)
+				}
+			}
+		}
+	}
+
+	return assets, nil
+}
+
+// Import creates the given assets, or updates them when an object with the same
+// natural key exists. Assets are imported in dependency order, so an asset may
+// reference any object found in the document or already present in AWX.
+func (s *AssetService) Import(assets *Assets) error {
+	c := newAssetSession(s)
+
+	for _, step := range []struct {
+		typ    string
+		assets []Asset
+	}{
+		{"organization", assets.Organizations},
+		{"credential", assets.Credentials},
+		{"project", assets.Projects},
+		{"inventory", assets.Inventory},
+		{"job_template", assets.JobTemplates},
+		{"workflow_job_template", assets.WorkflowJobTemplates},
+	} {
+		for _, a := range step.assets {
+			id, err := c.upsert(step.typ, a)
+			if err != nil {
+				return err
+			}
+			if step.typ != "workflow_job_template" {
+				if err := c.importRelated(step.typ, id, a); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// Workflow nodes may run any workflow job template, so they come once all of them exist.
+	for _, a := range assets.WorkflowJobTemplates {
+		id, err := c.resolve(a["natural_key"])
+		if err != nil {
+			return err
+		}
+		if err := c.importRelated("workflow_job_template", id, a); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// assetSession caches the natural keys and ids resolved during an export or import.
+type assetSession struct {
+	s    *AssetService
+	keys map[string]NaturalKey
+	ids  map[string]int
+}
+
+func newAssetSession(s *AssetService) *assetSession {
+	return &assetSession{
+		s:    s,
+		keys: make(map[string]NaturalKey),
+		ids:  make(map[string]int),
+	}
+}
+
+// asset converts an object returned by the API to an asset of type typ.
+func (c *assetSession) asset(typ string, obj map[string]interface{}) (Asset, error) {
+	a := Asset{}
+	for _, field := range assetTypes[typ].fields {
+		value, ok := obj[field]
+		if !ok {
+			continue
+		}
+		if refType, isRef := assetRefs[field]; isRef {
+			ref, err := c.refKey(refType, value)
+			if err != nil {
+				return nil, err
+			}
+			a[field] = ref
+			continue
+		}
+		a[field] = value
+	}
+
+	key, err := c.buildKey(typ, obj)
+	if err != nil {
+		return nil, err
+	}
+	a["natural_key"] = key
+	return a, nil
+}
+
+// refKey returns the natural key of the object of refType with the id held in value,
+// or nil when the relation is not set.
+func (c *assetSession) refKey(refType string, value interface{}) (interface{}, error) {
+	id, ok := intValue(value)
+	if !ok {
+		return nil, nil
+	}
+	return c.naturalKey(refType, id)
+}
+
+func (c *assetSession) buildKey(typ string, obj map[string]interface{}) (NaturalKey, error) {
+	key := NaturalKey{"type": typ}
+	for _, field := range assetTypes[typ].key {
+		refType, isRef := assetRefs[field]
+		if !isRef {
+			key[field] = obj[field]
+			continue
+		}
+		ref, err := c.refKey(refType, obj[field])
+		if err != nil {
+			return nil, err
+		}
+		key[field] = ref
+	}
+	return key, nil
+}
+
+// naturalKey returns the natural key of the object of typ with the given id. An empty
+// typ stands for a unified job template, whose concrete type is looked up first.
+func (c *assetSession) naturalKey(typ string, id int) (NaturalKey, error) {
+	cacheKey := fmt.Sprintf("%s:%d", typ, id)
+	if key, ok := c.keys[cacheKey]; ok {
+		return key, nil
+	}
+
+	if typ == "" {
+		ujts, err := c.s.list(unifiedJobTemplatesAPIEndpoint, map[string]string{"id": strconv.Itoa(id)})
+		if err != nil {
+			return nil, err
+		}
+		if len(ujts) != 1 {
+			return nil, fmt.Errorf("unified job template %d not found", id)
+		}
+		typ, _ = ujts[0]["type"].(string)
+	}
+
+	t, ok := assetTypes[typ]
+	if !ok {
+		return nil, fmt.Errorf("unsupported asset type %q", typ)
+	}
+	obj := make(map[string]interface{})
+	if err := c.s.get(fmt.Sprintf("%s%d/", t.endpoint, id), nil, &obj); err != nil {
+		return nil, err
+	}
+
+	key, err := c.buildKey(typ, obj)
+	if err != nil {
+		return nil, err
+	}
+	c.keys[cacheKey] = key
+	return key, nil
+}
+
+// exportRelated adds the objects owned by obj, which are not listed at the top level
+// of the document, to its asset.
+func (c *assetSession) exportRelated(typ string, obj map[string]interface{}, a Asset) error {
+	id, _ := intValue(obj["id"])
+	related := make(map[string]interface{})
+
+	switch typ {
+	case "inventory":
+		filter := map[string]string{"inventory": strconv.Itoa(id)}
+
+		// Hosts and groups created by an inventory source are left to the source.
+		for _, item := range []struct{ typ, name string }{{"inventory_source", "inventory_sources"}, {"host", "hosts"}, {"group", "groups"}} {
+			objects, err := c.s.list(assetTypes[item.typ].endpoint, filter)
+			if err != nil {
+				return err
+			}
+			assets := make([]Asset, 0, len(objects))
+			for _, o := range objects {
+				if managed, _ := o["has_inventory_sources"].(bool); managed {
+					continue
+				}
+				child, err := c.asset(item.typ, o)
+				if err != nil {
+					return err
+				}
+				if err := c.exportRelated(item.typ, o, child); err != nil {
+					return err
+				}
+				assets = append(assets, child)
+			}
+			related[item.name] = assets
+		}
+	case "group":
+		for _, item := range []struct{ typ, name string }{{"host", "hosts"}, {"group", "children"}} {
+			keys, err := c.relatedKeys(item.typ, fmt.Sprintf("%s%d/%s/", groupsAPIEndpoint, id, item.name))
+			if err != nil {
+				return err
+			}
+			related[item.name] = keys
+		}
+	case "job_template":
+		keys, err := c.relatedKeys("credential", fmt.Sprintf("%s%d/credentials/", jobTemplateAPIEndpoint, id))
+		if err != nil {
+			return err
+		}
+		related["credentials"] = keys
+	case "workflow_job_template":
+		nodes, err := c.s.list(workflowJobTemplateNodeAPIEndpoint, map[string]string{"workflow_job_template": strconv.Itoa(id)})
+		if err != nil {
+			return err
+		}
+		assets := make([]Asset, 0, len(nodes))
+		for _, n := range nodes {
+			node, err := c.asset("workflow_job_template_node", n)
+			if err != nil {
+				return err
+			}
+			for _, edge := range workflowNodeEdges {
+				children, _ := n[edge].([]interface{})
+				keys := make([]NaturalKey, 0, len(children))
+				for _, child := range children {
+					childID, _ := intValue(child)
+					key, err := c.naturalKey("workflow_job_template_node", childID)
+					if err != nil {
+						return err
+					}
+					keys = append(keys, key)
+				}
+				node[edge] = keys
+			}
+			assets = append(assets, node)
+		}
+		related["workflow_nodes"] = assets
+	default:
+		return nil
+	}
+
+	a["related"] = related
+	return nil
+}
+
+func (c *assetSession) relatedKeys(typ, endpoint string) ([]NaturalKey, error) {
+	objects, err := c.s.list(endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]NaturalKey, 0, len(objects))
+	for _, o := range objects {
+		key, err := c.buildKey(typ, o)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// upsert creates or updates the object described by a and returns its id.
+func (c *assetSession) upsert(typ string, a Asset) (int, error) {
+	key, ok := naturalKeyOf(a["natural_key"])
+	if !ok || key["type"] != typ {
+		return 0, fmt.Errorf("%s asset without a %s natural key", typ, typ)
+	}
+
+	id, err := c.lookup(key)
+	if err != nil {
+		return 0, err
+	}
+
+	data := make(map[string]interface{})
+	for _, field := range assetTypes[typ].fields {
+		value, ok := a[field]
+		if !ok {
+			continue
+		}
+		if _, isRef := assetRefs[field]; isRef && value != nil {
+			refID, err := c.resolve(value)
+			if err != nil {
+				return 0, fmt.Errorf("%s %s: %s", typ, field, err)
+			}
+			value = refID
+		}
+		data[field] = value
+	}
+
+	// Secret inputs are exported masked. AWX keeps the current value when the mask is
+	// sent back on update, but it must not become the secret of a new credential.
+	if inputs, ok := data["inputs"].(map[string]interface{}); ok && id == 0 && typ == "credential" {
+		for k, v := range inputs {
+			if v == encryptedValue {
+				delete(inputs, k)
+			}
+		}
+	}
+
+	result := make(map[string]interface{})
+	if id == 0 {
+		err = c.s.send("POST", assetTypes[typ].endpoint, data, &result)
+	} else {
+		err = c.s.send("PATCH", fmt.Sprintf("%s%d/", assetTypes[typ].endpoint, id), data, &result)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%s %v: %s", typ, key["name"], err)
+	}
+
+	if id == 0 {
+		id, _ = intValue(result["id"])
+	}
+	c.ids[cacheKeyOf(key)] = id
+	return id, nil
+}
+
+// importRelated imports the objects owned by the object of typ with the given id.
+func (c *assetSession) importRelated(typ string, id int, a Asset) error {
+	related, _ := a["related"].(map[string]interface{})
+	if related == nil {
+		return nil
+	}
+
+	switch typ {
+	case "inventory":
+		// Groups are associated with the hosts once both exist, and sources come last as
+		// they reference projects and credentials rather than the inventory content.
+		groups := make(map[int]Asset)
+		for _, item := range []struct{ typ, name string }{{"host", "hosts"}, {"group", "groups"}, {"inventory_source", "inventory_sources"}} {
+			for _, child := range assetList(related[item.name]) {
+				childID, err := c.upsert(item.typ, child)
+				if err != nil {
+					return err
+				}
+				if item.typ == "group" {
+					groups[childID] = child
+				}
+			}
+		}
+		for groupID, group := range groups {
+			if err := c.importRelated("group", groupID, group); err != nil {
+				return err
+			}
+		}
+	case "group":
+		for _, name := range []string{"hosts", "children"} {
+			if err := c.associate(fmt.Sprintf("%s%d/%s/", groupsAPIEndpoint, id, name), related[name]); err != nil {
+				return err
+			}
+		}
+	case "job_template":
+		return c.associate(fmt.Sprintf("%s%d/credentials/", jobTemplateAPIEndpoint, id), related["credentials"])
+	case "workflow_job_template":
+		nodes := assetList(related["workflow_nodes"])
+		ids := make([]int, 0, len(nodes))
+		for _, node := range nodes {
+			nodeID, err := c.upsert("workflow_job_template_node", node)
+			if err != nil {
+				return err
+			}
+			ids = append(ids, nodeID)
+		}
+		for i, node := range nodes {
+			for _, edge := range workflowNodeEdges {
+				if err := c.associate(fmt.Sprintf("%s%d/%s/", workflowJobTemplateNodeAPIEndpoint, ids[i], edge), node[edge]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// associate adds the objects with the given natural keys to a related list endpoint.
+// AWX ignores objects which are already associated.
+func (c *assetSession) associate(endpoint string, keys interface{}) error {
+	var list []interface{}
+	switch v := keys.(type) {
+	case []interface{}:
+		list = v
+	case []NaturalKey:
+		for _, key := range v {
+			list = append(list, key)
+		}
+	}
+	for _, key := range list {
+		id, err := c.resolve(key)
+		if err != nil {
+			return err
+		}
+		if err := c.s.send("POST", endpoint, map[string]interface{}{"id": id}, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolve returns the id of the object with the given natural key, which must exist.
+func (c *assetSession) resolve(value interface{}) (int, error) {
+	key, ok := naturalKeyOf(value)
+	if !ok {
+		return 0, fmt.Errorf("invalid natural key %v", value)
+	}
+	id, err := c.lookup(key)
+	if err != nil {
+		return 0, err
+	}
+	if id == 0 {
+		return 0, fmt.Errorf("%v %v not found", key["type"], key["name"])
+	}
+	return id, nil
+}
+
+// lookup returns the id of the object with the given natural key, or 0 when there is none.
+func (c *assetSession) lookup(key NaturalKey) (int, error) {
+	cacheKey := cacheKeyOf(key)
+	if id, ok := c.ids[cacheKey]; ok {
+		return id, nil
+	}
+
+	typ, _ := key["type"].(string)
+	t, ok := assetTypes[typ]
+	if !ok {
+		return 0, fmt.Errorf("unsupported asset type %q", typ)
+	}
+
+	filter := make(map[string]string)
+	for _, field := range t.key {
+		value := key[field]
+		if _, isRef := assetRefs[field]; !isRef {
+			filter[field] = fmt.Sprint(value)
+			continue
+		}
+		if value == nil {
+			filter[field+"__isnull"] = "true"
+			continue
+		}
+		id, err := c.lookup(NaturalKey(mapValue(value)))
+		if err != nil || id == 0 {
+			return 0, err
+		}
+		filter[field] = strconv.Itoa(id)
+	}
+
+	objects, err := c.s.list(t.endpoint, filter)
+	if err != nil {
+		return 0, err
+	}
+	switch len(objects) {
+	case 0:
+		return 0, nil
+	case 1:
+		id, _ := intValue(objects[0]["id"])
+		c.ids[cacheKey] = id
+		return id, nil
+	default:
+		return 0, fmt.Errorf("%d objects match the natural key %s", len(objects), cacheKey)
+	}
+}
+
+func (s *AssetService) list(endpoint string, params map[string]string) ([]map[string]interface{}, error) {
+	results := make([]map[string]interface{}, 0)
+	nextURL := endpoint
+	for {
+		nextURLParsed, err := url.Parse(nextURL)
+		if err != nil {
+			return nil, err
+		}
+
+		query := make(map[string]string)
+		for paramName, paramValues := range nextURLParsed.Query() {
+			if len(paramValues) > 0 {
+				query[paramName] = paramValues[0]
+			}
+		}
+		for paramName, paramValue := range params {
+			query[paramName] = paramValue
+		}
+
+		result := new(listAssetsResponse)
+		if err := s.get(nextURLParsed.Path, query, result); err != nil {
+			return nil, err
+		}
+		results = append(results, result.Results...)
+
+		next, _ := result.Next.(string)
+		if next == "" {
+			return results, nil
+		}
+		nextURL = next
+	}
+}
+
+func (s *AssetService) get(endpoint string, params map[string]string, result interface{}) error {
+	resp, err := s.client.Requester.GetJSON(endpoint, result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return err
+	}
+
+	return CheckResponse(resp)
+}
+
+func (s *AssetService) send(method, endpoint string, data map[string]interface{}, result interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	ar := NewAPIRequest(method, endpoint, bytes.NewReader(payload))
+	ar.SetHeader("Content-Type", "application/json")
+	resp, err := s.client.Requester.Do(ar, result)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return err
+	}
+
+	return CheckResponse(resp)
+}
+
+// intValue returns the id held in a JSON decoded value.
+func intValue(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case float64:
+		return int(v), true
+	case int:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func mapValue(value interface{}) map[string]interface{} {
+	switch v := value.(type) {
+	case NaturalKey:
+		return v
+	case map[string]interface{}:
+		return v
+	default:
+		return nil
+	}
+}
+
+func naturalKeyOf(value interface{}) (NaturalKey, bool) {
+	m := mapValue(value)
+	if _, ok := m["type"].(string); !ok {
+		return nil, false
+	}
+	return NaturalKey(m), true
+}
+
+func cacheKeyOf(key NaturalKey) string {
+	b, _ := json.Marshal(key)
+	return string(b)
+}
+
+func assetList(value interface{}) []Asset {
+	var assets []Asset
+	switch v := value.(type) {
+	case []Asset:
+		return v
+	case []interface{}:
+		for _, item := range v {
+			if m := mapValue(item); m != nil {
+				assets = append(assets, m)
+			}
+		}
+	}
+	return assets
+}
@@ -0,0 +1,176 @@
+package awx_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+)
+
+func newFakeAWX(t *testing.T, firstID int) (*awxtest.FakeAWX, *awx.AWX) {
+	t.Helper()
+	f := awxtest.NewFakeAWX(firstID)
+	return f, awxtest.NewClient(t, f)
+}
+
+// seedFakeAWX fills f with an organization owning one object of every exported type.
+func seedFakeAWX(f *awxtest.FakeAWX) {
+	machine := f.Add("credential_types", map[string]interface{}{"name": "Machine", "kind": "ssh"})
+	org := f.Add("organizations", map[string]interface{}{"name": "Default", "description": "", "max_hosts": 0})
+	f.Add("organizations", map[string]interface{}{"name": "Other", "description": "", "max_hosts": 0})
+
+	cred := f.Add("credentials", map[string]interface{}{
+		"name": "ssh", "description": "", "organization": org, "credential_type": machine,
+		"inputs": map[string]interface{}{"username": "root", "password": "$encrypted$"},
+	})
+	project := f.Add("projects", map[string]interface{}{
+		"name": "playbooks", "description": "", "organization": org, "scm_type": "git",
+		"scm_url": "https://example.com/playbooks.git", "scm_branch": "main", "credential": cred,
+	})
+	inv := f.Add("inventories", map[string]interface{}{
+		"name": "servers", "description": "", "organization": org, "kind": "", "host_filter": nil, "variables": "env: prod\n",
+	})
+	web1 := f.Add("hosts", map[string]interface{}{"name": "web1", "inventory": inv, "enabled": true, "variables": ""})
+	f.Add("hosts", map[string]interface{}{"name": "db1", "inventory": inv, "enabled": false, "variables": ""})
+	f.Add("hosts", map[string]interface{}{"name": "cloud1", "inventory": inv, "has_inventory_sources": true})
+	web := f.Add("groups", map[string]interface{}{"name": "web", "inventory": inv, "variables": "", "hosts": []int{web1}})
+	f.Add("groups", map[string]interface{}{"name": "prod", "inventory": inv, "variables": "", "children": []int{web}})
+	f.Add("inventory_sources", map[string]interface{}{
+		"name": "scm", "inventory": inv, "source": "scm", "source_project": project, "source_path": "inventory.yml", "credential": nil,
+	})
+
+	jt := f.Add("job_templates", map[string]interface{}{
+		"name": "deploy", "description": "", "organization": org, "job_type": "run", "inventory": inv, "project": project,
+		"playbook": "deploy.yml", "extra_vars": "---\nversion: 1\n", "credentials": []int{cred},
+	})
+	wfjt := f.Add("workflow_job_templates", map[string]interface{}{"name": "pipeline", "description": "", "organization": org, "inventory": nil})
+	second := f.Add("workflow_job_template_nodes", map[string]interface{}{
+		"workflow_job_template": wfjt, "unified_job_template": jt, "identifier": "second", "inventory": nil,
+	})
+	f.Add("workflow_job_template_nodes", map[string]interface{}{
+		"workflow_job_template": wfjt, "unified_job_template": jt, "identifier": "first", "inventory": nil,
+		"success_nodes": []int{second},
+	})
+}
+
+func TestAssetServiceExport(t *testing.T) {
+	src, client := newFakeAWX(t, 1)
+	seedFakeAWX(src)
+
+	assets, err := client.AssetService.Export(map[string]string{"name": "Default"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(assets.Organizations) != 1 || len(assets.Credentials) != 1 || len(assets.Projects) != 1 ||
+		len(assets.Inventory) != 1 || len(assets.JobTemplates) != 1 || len(assets.WorkflowJobTemplates) != 1 {
+		t.Fatalf("Expecting one asset of each type but got %+v", assets)
+	}
+
+	b, err := json.Marshal(assets.Projects[0]["credential"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"credential_type":{"kind":"ssh","name":"Machine","type":"credential_type"},"name":"ssh",` +
+		`"organization":{"name":"Default","type":"organization"},"type":"credential"}`
+	if string(b) != expected {
+		t.Errorf("Expecting project credential %s but got %s", expected, b)
+	}
+
+	related := assets.Inventory[0]["related"].(map[string]interface{})
+	if hosts := related["hosts"].([]awx.Asset); len(hosts) != 2 {
+		t.Errorf("Expecting the hosts not created by an inventory source but got %v", hosts)
+	}
+	if _, ok := assets.Inventory[0]["id"]; ok {
+		t.Error("Expecting exported assets without ids")
+	}
+}
+
+func TestAssetServiceImport(t *testing.T) {
+	src, srcClient := newFakeAWX(t, 1)
+	seedFakeAWX(src)
+	dst, dstClient := newFakeAWX(t, 1000)
+	dst.Add("credential_types", map[string]interface{}{"name": "Machine", "kind": "ssh"})
+
+	exported, err := srcClient.AssetService.Export(map[string]string{"name": "Default"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Go through the JSON document, as `awx import` would.
+	doc, err := json.Marshal(exported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assets := new(awx.Assets)
+	if err := json.Unmarshal(doc, assets); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		if err := dstClient.AssetService.Import(assets); err != nil {
+			t.Fatal(err)
+		}
+
+		cred := dst.Find("credentials", "ssh")
+		if _, ok := cred["inputs"].(map[string]interface{})["password"]; ok {
+			t.Error("Expecting the masked password not to be set on the new credential")
+		}
+
+		first, second := dst.Find("workflow_job_template_nodes", "first"), dst.Find("workflow_job_template_nodes", "second")
+		if edges := first["success_nodes"].([]interface{}); len(edges) != 1 || edges[0] != second["id"] {
+			t.Errorf("Expecting the first node to lead to node %v but got %v", second["id"], edges)
+		}
+		if second["unified_job_template"] != dst.Find("job_templates", "deploy")["id"] {
+			t.Errorf("Expecting the node to run the imported job template but got %v", second["unified_job_template"])
+		}
+	})
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		reexported, err := dstClient.AssetService.Export(map[string]string{"name": "Default"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := json.Marshal(reexported)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		delete(assets.Credentials[0]["inputs"].(map[string]interface{}), "password")
+		want, err := json.Marshal(assets)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("Expecting the imported content to export as\n%s\nbut got\n%s", want, got)
+		}
+	})
+
+	t.Run("Idempotent", func(t *testing.T) {
+		before := dst.Count()
+		if err := dstClient.AssetService.Import(assets); err != nil {
+			t.Fatal(err)
+		}
+		if after := dst.Count(); after != before {
+			t.Errorf("Expecting %d objects after importing twice but got %d", before, after)
+		}
+	})
+}
+
+func TestAssetServiceImportMissingDependency(t *testing.T) {
+	src, srcClient := newFakeAWX(t, 1)
+	seedFakeAWX(src)
+	_, dstClient := newFakeAWX(t, 1000)
+
+	assets, err := srcClient.AssetService.Export(map[string]string{"name": "Default"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = dstClient.AssetService.Import(assets)
+	if err == nil || !strings.Contains(err.Error(), "credential_type Machine not found") {
+		t.Errorf("Expecting the missing credential type to be reported but got %v", err)
+	}
+}
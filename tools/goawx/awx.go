@@ -1,6 +1,7 @@
 package awx
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -15,7 +16,13 @@ var mandatoryFields []string
 type AWX struct {
 	client *Client
 
+	// AdoptExisting is the default of the Terraform provider resources for taking over an
+	// existing object with the same name instead of failing to create it.
+	AdoptExisting bool
+
+	AdHocCommandService                             *AdHocCommandService
 	ApplicationService                              *ApplicationService
+	AssetService                                    *AssetService
 	ExecutionEnvironmentsService                    *ExecutionEnvironmentsService
 	PingService                                     *PingService
 	InventoriesService                              *InventoriesService
@@ -38,6 +45,7 @@ type AWX struct {
 	ScheduleService                                 *SchedulesService
 	SettingService                                  *SettingService
 	TeamService                                     *TeamService
+	WorkflowJobService                              *WorkflowJobService
 	WorkflowJobTemplateScheduleService              *WorkflowJobTemplateScheduleService
 	WorkflowJobTemplateService                      *WorkflowJobTemplateService
 	WorkflowJobTemplateNodeService                  *WorkflowJobTemplateNodeService
@@ -53,13 +61,29 @@ type Client struct {
 	Requester *Requester
 }
 
-// CheckResponse do http response check, and return err if not in [200, 300).
+// ResponseError is returned by CheckResponse for a response not in [200, 300).
+type ResponseError struct {
+	StatusCode int
+	Response   *http.Response
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("responsed with %d, resp: %v", e.StatusCode, e.Response)
+}
+
+// CheckResponse do http response check, and return a *ResponseError if not in [200, 300).
 func CheckResponse(resp *http.Response) error {
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
 		return nil
 	}
 
-	return fmt.Errorf("responsed with %d, resp: %v", resp.StatusCode, resp)
+	return &ResponseError{StatusCode: resp.StatusCode, Response: resp}
+}
+
+// IsNotFound reports whether err is a 404 response, the object does not exist.
+func IsNotFound(err error) bool {
+	var respErr *ResponseError
+	return errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound
 }
 
 // ValidateParams is to validate the input to use the services.
@@ -127,9 +151,15 @@ func newAWX(c *Client) *AWX { //nolint: funlen
 	return &AWX{
 		client: c,
 
+		AdHocCommandService: &AdHocCommandService{
+			client: c,
+		},
 		ApplicationService: &ApplicationService{
 			client: c,
 		},
+		AssetService: &AssetService{
+			client: c,
+		},
 		ExecutionEnvironmentsService: &ExecutionEnvironmentsService{
 			client: c,
 		},
@@ -196,6 +226,9 @@ func newAWX(c *Client) *AWX { //nolint: funlen
 		TeamService: &TeamService{
 			client: c,
 		},
+		WorkflowJobService: &WorkflowJobService{
+			client: c,
+		},
 		WorkflowJobTemplateScheduleService: &WorkflowJobTemplateScheduleService{
 			client: c,
 		},
@@ -0,0 +1,263 @@
+// Package awxtest provides an in-memory AWX API to test the clients of goawx against.
+package awxtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+// PageSize is the number of results in every page of a list.
+const PageSize = 2
+
+// unifiedJobTemplates maps the collections listed by /api/v2/unified_job_templates/ to their type.
+//
+//nolint:gochecknoglobals
+var unifiedJobTemplates = map[string]string{
+	"job_templates":          "job_template",
+	"workflow_job_templates": "workflow_job_template",
+	"projects":               "project",
+	"inventory_sources":      "inventory_source",
+}
+
+// subLists maps the related lists of an object to the collection of their items.
+//
+//nolint:gochecknoglobals
+var subLists = map[string]string{
+	"credentials":                    "credentials",
+	"hosts":                          "hosts",
+	"children":                       "groups",
+	"success_nodes":                  "workflow_job_template_nodes",
+	"failure_nodes":                  "workflow_job_template_nodes",
+	"always_nodes":                   "workflow_job_template_nodes",
+	"roles":                          "roles",
+	"notification_templates_started": "notification_templates",
+	"notification_templates_success": "notification_templates",
+	"notification_templates_error":   "notification_templates",
+}
+
+// FakeAWX is an in-memory AWX API supporting filtered and paginated lists, creation,
+// updates and the association of related objects.
+type FakeAWX struct {
+	mu      sync.Mutex
+	nextID  int
+	objects map[string]map[int]map[string]interface{}
+}
+
+// NewFakeAWX returns an empty FakeAWX numbering the objects it stores from firstID.
+func NewFakeAWX(firstID int) *FakeAWX {
+	return &FakeAWX{nextID: firstID, objects: make(map[string]map[int]map[string]interface{})}
+}
+
+// NewClient serves handler behind the ping endpoint checked by awx.NewAWX and returns a
+// client of it.
+func NewClient(t testing.TB, handler http.Handler) *awx.AWX {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping/" {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{}`)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := awx.NewAWX(srv.URL, "admin", "password", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// Add stores obj in collection and returns its id.
+func (f *FakeAWX) Add(collection string, obj map[string]interface{}) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.create(collection, obj)
+}
+
+func (f *FakeAWX) create(collection string, obj map[string]interface{}) int {
+	// Store what a JSON client would see, so numbers are float64 as in real responses.
+	b, _ := json.Marshal(obj)
+	stored := make(map[string]interface{})
+	_ = json.Unmarshal(b, &stored)
+
+	id := f.nextID
+	f.nextID++
+	stored["id"] = float64(id)
+	if f.objects[collection] == nil {
+		f.objects[collection] = make(map[int]map[string]interface{})
+	}
+	f.objects[collection][id] = stored
+	return id
+}
+
+// Count returns the number of stored objects.
+func (f *FakeAWX) Count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := 0
+	for _, objects := range f.objects {
+		n += len(objects)
+	}
+	return n
+}
+
+// Find returns the object of collection with the given name or identifier, nil when there is none.
+func (f *FakeAWX) Find(collection, name string) map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, obj := range f.objects[collection] {
+		if obj["name"] == name || obj["identifier"] == name {
+			return obj
+		}
+	}
+	return nil
+}
+
+// ServeHTTP answers the requests to the AWX API from the stored objects.
+func (f *FakeAWX) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/v2/"), "/"), "/")
+	collection := parts[0]
+
+	var body map[string]interface{}
+	if r.Method == http.MethodPost || r.Method == http.MethodPatch {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"detail": %q}`, err.Error())
+			return
+		}
+	}
+
+	switch {
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		f.list(w, r, f.collection(collection))
+	case len(parts) == 1 && r.Method == http.MethodPost:
+		id := f.create(collection, body)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(f.objects[collection][id])
+	case len(parts) >= 2:
+		id, _ := strconv.Atoi(parts[1])
+		obj, ok := f.objects[collection][id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"detail": "Not found."}`)
+			return
+		}
+		if len(parts) == 3 {
+			f.subList(w, r, obj, parts[2], body)
+			return
+		}
+		if r.Method == http.MethodPatch {
+			for k, v := range body {
+				obj[k] = v
+			}
+		}
+		_ = json.NewEncoder(w).Encode(obj)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *FakeAWX) collection(name string) []map[string]interface{} {
+	var objects []map[string]interface{}
+	if name == "unified_job_templates" {
+		for collection, typ := range unifiedJobTemplates {
+			for _, obj := range f.objects[collection] {
+				ujt := map[string]interface{}{"type": typ}
+				for k, v := range obj {
+					ujt[k] = v
+				}
+				objects = append(objects, ujt)
+			}
+		}
+	} else {
+		for _, obj := range f.objects[name] {
+			objects = append(objects, obj)
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i]["id"].(float64) < objects[j]["id"].(float64) })
+	return objects
+}
+
+func (f *FakeAWX) subList(w http.ResponseWriter, r *http.Request, obj map[string]interface{}, name string, body map[string]interface{}) {
+	ids, _ := obj[name].([]interface{})
+	if r.Method == http.MethodPost {
+		for _, id := range ids {
+			if id == body["id"] {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		obj[name] = append(ids, body["id"])
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	var objects []map[string]interface{}
+	for _, id := range ids {
+		objects = append(objects, f.objects[subLists[name]][int(id.(float64))])
+	}
+	f.list(w, r, objects)
+}
+
+func (f *FakeAWX) list(w http.ResponseWriter, r *http.Request, objects []map[string]interface{}) {
+	query := r.URL.Query()
+	var matched []map[string]interface{}
+	for _, obj := range objects {
+		if matches(obj, query) {
+			matched = append(matched, obj)
+		}
+	}
+
+	page, _ := strconv.Atoi(query.Get("page"))
+	if page == 0 {
+		page = 1
+	}
+	start := (page - 1) * PageSize
+	end := start + PageSize
+	if end > len(matched) {
+		end = len(matched)
+	}
+
+	var next interface{}
+	if end < len(matched) {
+		query.Set("page", strconv.Itoa(page+1))
+		next = (&url.URL{Path: r.URL.Path, RawQuery: query.Encode()}).String()
+	}
+	results := make([]map[string]interface{}, 0)
+	if start < len(matched) {
+		results = matched[start:end]
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"count": len(matched), "next": next, "results": results})
+}
+
+func matches(obj map[string]interface{}, query url.Values) bool {
+	for k := range query {
+		v := query.Get(k)
+		switch {
+		case k == "page":
+		case strings.HasSuffix(k, "__isnull"):
+			if (obj[strings.TrimSuffix(k, "__isnull")] == nil) != (v == "true") {
+				return false
+			}
+		case fmt.Sprint(obj[k]) != v:
+			return false
+		}
+	}
+	return true
+}
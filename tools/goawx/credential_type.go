@@ -144,6 +144,26 @@ func (cs *CredentialTypeService) GetCredentialTypeByName(name string, params map
 	return nil, fmt.Errorf("could not find credential type with name %s", name)
 }
 
+// GetCredentialTypeByNamespace : Fetches the credential type managed by AWX with the given namespace and kind,
+// whose ID depends on the install.
+func (cs *CredentialTypeService) GetCredentialTypeByNamespace(namespace, kind string, params map[string]string) (*CredentialType, error) {
+	filters := map[string]string{"namespace": namespace, "kind": kind}
+	for k, v := range params {
+		filters[k] = v
+	}
+	credentialTypes, err := cs.ListCredentialTypes(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, credentialType := range credentialTypes {
+		if credentialType.Managed || credentialType.ManagedByTower {
+			return credentialType, nil
+		}
+	}
+	return nil, fmt.Errorf("could not find managed credential type with namespace %s and kind %s", namespace, kind)
+}
+
 // UpdateCredentialTypeByID : Updates a credential type by ID.
 func (cs *CredentialTypeService) UpdateCredentialTypeByID(id int, data map[string]interface{}, params map[string]string) (*CredentialType, error) {
 	result := new(CredentialType)
@@ -196,3 +216,28 @@ func (cs *CredentialTypeService) DeleteCredentialTypeByID(id int, params map[str
 
 	return nil
 }
+
+// TestCredentialType : Checks that an external credential type with the given inputs resolves a
+// secret with the given metadata, without creating a credential. AWX describes the failure in the
+// returned error.
+func (cs *CredentialTypeService) TestCredentialType(id int, inputs, metadata map[string]interface{}, params map[string]string) error {
+	payload, err := json.Marshal(map[string]interface{}{"inputs": inputs, "metadata": metadata})
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s%d/test/", credentialTypesAPIEndpoint, id)
+	resp, err := cs.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), nil, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return err
+	}
+
+	return CheckResponse(resp)
+}
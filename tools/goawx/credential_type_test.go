@@ -0,0 +1,24 @@
+package awx_test
+
+import (
+	"testing"
+)
+
+func TestCredentialTypeServiceGetCredentialTypeByNamespace(t *testing.T) {
+	f, client := newFakeAWX(t, 40)
+	f.Add("credential_types", map[string]interface{}{"name": "Custom Machine", "kind": "ssh", "namespace": "ssh", "managed": false})
+	machine := f.Add("credential_types", map[string]interface{}{"name": "Machine", "kind": "ssh", "namespace": "ssh", "managed": true})
+	f.Add("credential_types", map[string]interface{}{"name": "Source Control", "kind": "scm", "namespace": "scm", "managed": true})
+
+	credentialType, err := client.CredentialTypeService.GetCredentialTypeByNamespace("ssh", "ssh", map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if credentialType.ID != machine {
+		t.Fatalf("Expecting the managed Machine credential type %d but got %+v", machine, credentialType)
+	}
+
+	if _, err := client.CredentialTypeService.GetCredentialTypeByNamespace("scm", "ssh", map[string]string{}); err == nil {
+		t.Fatal("Expecting an error for a namespace of another kind")
+	}
+}
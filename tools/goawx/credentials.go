@@ -181,3 +181,27 @@ func (cs *CredentialsService) DeleteCredentialsByID(id int, params map[string]st
 
 	return nil
 }
+
+// TestCredential : Checks that an external credential resolves a secret with the given metadata,
+// AWX describes the failure in the returned error.
+func (cs *CredentialsService) TestCredential(id int, metadata map[string]interface{}, params map[string]string) error {
+	payload, err := json.Marshal(map[string]interface{}{"metadata": metadata})
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s%d/test/", credentialsAPIEndpoint, id)
+	resp, err := cs.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), nil, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return err
+	}
+
+	return CheckResponse(resp)
+}
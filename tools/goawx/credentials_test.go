@@ -0,0 +1,63 @@
+package awx_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// testEndpoint records the requests to a credential test endpoint, accepting those whose
+// metadata has a secret_path of /kv/found.
+func testEndpoint(t *testing.T, requests *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		*requests = append(*requests, fmt.Sprintf("%s %s %v", r.Method, r.URL.Path, body))
+		if body["metadata"]["secret_path"] != "/kv/found" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"inputs": "HTTP 404: not found"}`)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{}`)
+	}
+}
+
+func TestCredentialsServiceTestCredential(t *testing.T) {
+	var requests []string
+	client := newRouteAWX(t, routes{"POST /api/v2/credentials/5/test/": testEndpoint(t, &requests)})
+
+	if err := client.CredentialsService.TestCredential(5, map[string]interface{}{"secret_path": "/kv/found"}, map[string]string{}); err != nil {
+		t.Fatal(err)
+	}
+	err := client.CredentialsService.TestCredential(5, map[string]interface{}{"secret_path": "/kv/missing"}, map[string]string{})
+	if err == nil || !strings.Contains(err.Error(), "HTTP 404: not found") {
+		t.Fatalf("Expecting the error returned by AWX but got %v", err)
+	}
+
+	if len(requests) != 2 || !strings.HasPrefix(requests[0], "POST /api/v2/credentials/5/test/ ") {
+		t.Fatalf("Unexpected requests %v", requests)
+	}
+}
+
+func TestCredentialTypeServiceTestCredentialType(t *testing.T) {
+	var requests []string
+	client := newRouteAWX(t, routes{"POST /api/v2/credential_types/7/test/": testEndpoint(t, &requests)})
+
+	inputs := map[string]interface{}{"url": "https://vault.example.com"}
+	if err := client.CredentialTypeService.TestCredentialType(7, inputs, map[string]interface{}{"secret_path": "/kv/found"}, map[string]string{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.CredentialTypeService.TestCredentialType(7, inputs, map[string]interface{}{}, map[string]string{}); err == nil {
+		t.Fatal("Expecting an error for a secret AWX can not resolve")
+	}
+
+	want := "POST /api/v2/credential_types/7/test/ map[inputs:map[url:https://vault.example.com] metadata:map[secret_path:/kv/found]]"
+	if len(requests) != 2 || requests[0] != want {
+		t.Fatalf("Expecting %q first but got %v", want, requests)
+	}
+}
@@ -0,0 +1,36 @@
+package awx_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+	"github.com/josh-silvas/terraform-provider-awx/tools/goawx/awxtest"
+)
+
+// routes maps a "METHOD /api/v2/path/" request to its handler.
+type routes map[string]http.HandlerFunc
+
+// newRouteAWX serves the JSON responses of routes and fails the test on any other request.
+func newRouteAWX(t *testing.T, routes routes) *awx.AWX {
+	t.Helper()
+	return awxtest.NewClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if handler, ok := routes[r.Method+" "+r.URL.Path]; ok {
+			handler(w, r)
+			return
+		}
+		t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"detail": "Not found."}`)
+	}))
+}
+
+// reply answers with status and body.
+func reply(status int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+		fmt.Fprint(w, body)
+	}
+}
@@ -175,6 +175,29 @@ func (i *InventoriesService) DeleteInventory(id int) (*Inventory, error) {
 	return result, nil
 }
 
+// ListInstanceGroups shows the instance groups associated to an awx Inventory.
+func (i *InventoriesService) ListInstanceGroups(id int, params map[string]string) ([]*InstanceGroup, *ListInstanceGroupsResponse, error) {
+	result := new(ListInstanceGroupsResponse)
+	endpoint := fmt.Sprintf("%s%d/instance_groups/", inventoriesAPIEndpoint, id)
+	resp, err := i.client.Requester.GetJSON(endpoint, result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
+
 // DisAssociateInstanceGroups remove InstanceGroup from an awx Inventory.
 func (i *InventoriesService) DisAssociateInstanceGroups(id int, data map[string]interface{}, _ map[string]string) (*Inventory, error) {
 	result := new(Inventory)
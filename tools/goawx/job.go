@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // Enum of job statuses.
@@ -18,6 +19,18 @@ const (
 	JobStatusCanceled   = "canceled"
 )
 
+// Enum of the job events of failing tasks.
+const (
+	JobEventRunnerOnFailed      = "runner_on_failed"
+	JobEventRunnerOnUnreachable = "runner_on_unreachable"
+)
+
+// Enum of the hosts a job is relaunched on.
+const (
+	RelaunchHostsAll    = "all"
+	RelaunchHostsFailed = "failed"
+)
+
 // JobService implements awx job apis.
 type JobService struct {
 	client *Client
@@ -121,6 +134,76 @@ func (j *JobService) RelaunchJob(id int, data map[string]interface{}, params map
 	return result, nil
 }
 
+// GetRelaunchOptions shows the passwords a job needs to be relaunched and the number of hosts it
+// would run on.
+func (j *JobService) GetRelaunchOptions(id int, params map[string]string) (*JobRelaunchOptions, error) {
+	result := new(JobRelaunchOptions)
+	endpoint := fmt.Sprintf("%s%d/relaunch/", jobAPIEndpoint, id)
+	resp, err := j.client.Requester.GetJSON(endpoint, result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Relaunch relaunches a job with typed options, after checking with the relaunch endpoint that
+// the passwords it needs are given and, when relaunched on the failed hosts, that some failed.
+func (j *JobService) Relaunch(id int, req *JobRelaunchRequest) (*JobLaunch, error) {
+	options, err := j.GetRelaunchOptions(id, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	var missing []string
+	for _, p := range options.PasswordsNeededToStart {
+		if _, ok := req.CredentialPasswords[p]; !ok {
+			missing = append(missing, p)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("job %d needs the passwords %s to be relaunched", id, strings.Join(missing, ", "))
+	}
+	if req.Hosts == RelaunchHostsFailed && options.RetryCounts != nil && options.RetryCounts.Failed == 0 {
+		return nil, fmt.Errorf("job %d has no failed hosts to relaunch", id)
+	}
+
+	result := new(JobLaunch)
+	endpoint := fmt.Sprintf("%s%d/relaunch/", jobAPIEndpoint, id)
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := j.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, map[string]string{})
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // GetHostSummaries get a job hosts summaries.
 func (j *JobService) GetHostSummaries(id int, params map[string]string) ([]HostSummary, *HostSummariesResponse, error) {
 	result := new(HostSummariesResponse)
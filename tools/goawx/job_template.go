@@ -146,6 +146,29 @@ func (jt *JobTemplateService) ListJobTemplates(params map[string]string) ([]*Job
 	return result.Results, result, nil
 }
 
+// GetLaunchOptions shows what the job template accepts when it is launched.
+func (jt *JobTemplateService) GetLaunchOptions(id int, params map[string]string) (*JobTemplateLaunchOptions, error) {
+	result := new(JobTemplateLaunchOptions)
+	endpoint := fmt.Sprintf("%s%d/launch/", jobTemplateAPIEndpoint, id)
+	resp, err := jt.client.Requester.GetJSON(endpoint, result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // Launch lauchs a job with the job template.
 func (jt *JobTemplateService) Launch(id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error) {
 	result := new(JobLaunch)
@@ -453,6 +476,29 @@ func (jt *JobTemplateService) AssociateCredentials(id int, data map[string]inter
 	return result, nil
 }
 
+// ListInstanceGroups shows the instance groups associated to an awx job template.
+func (jt *JobTemplateService) ListInstanceGroups(id int, params map[string]string) ([]*InstanceGroup, *ListInstanceGroupsResponse, error) {
+	result := new(ListInstanceGroupsResponse)
+	endpoint := fmt.Sprintf("%s%d/instance_groups/", jobTemplateAPIEndpoint, id)
+	resp, err := jt.client.Requester.GetJSON(endpoint, result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
+
 // DisAssociateInstanceGroups remove instance group from an awx job template.
 func (jt *JobTemplateService) DisAssociateInstanceGroups(id int, data map[string]interface{}, _ map[string]string) (*JobTemplate, error) {
 	result := new(JobTemplate)
@@ -119,3 +119,26 @@ func (jt *JobTemplateNotificationTemplatesService) DisassociateJobTemplateNotifi
 func (jt *JobTemplateNotificationTemplatesService) DisassociateJobTemplateNotificationTemplatesStarted(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
 	return jt.disassociateJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "started")
 }
+
+// ListJobTemplateNotificationTemplates lists the notification_templates of the given type (started, success or error) associated to a job_template.
+func (jt *JobTemplateNotificationTemplatesService) ListJobTemplateNotificationTemplates(jobTemplateID int, typ string, params map[string]string) ([]*NotificationTemplate, *ListNotificationTemplatesResponse, error) {
+	result := new(ListNotificationTemplatesResponse)
+	endpoint := fmt.Sprintf(jobTemplateNotificationTemplatesAPIEndpoint, jobTemplateID, typ)
+	resp, err := jt.client.Requester.GetJSON(endpoint, result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
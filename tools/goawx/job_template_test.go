@@ -0,0 +1,25 @@
+package awx_test
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestJobTemplateServiceGetLaunchOptions(t *testing.T) {
+	client := newRouteAWX(t, routes{
+		"GET /api/v2/job_templates/7/launch/": reply(http.StatusOK, `{"can_start_without_user_input": false, "ask_tags_on_launch": true,
+			"ask_instance_groups_on_launch": true, "inventory_needed_to_start": true,
+			"variables_needed_to_start": ["region"], "defaults": {"job_tags": "setup"}}`),
+	})
+
+	options, err := client.JobTemplateService.GetLaunchOptions(7, map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !options.AskTagsOnLaunch || !options.AskInstanceGroupsOnLaunch || options.AskLimitOnLaunch {
+		t.Fatalf("Unexpected ask flags %+v", options)
+	}
+	if !options.InventoryNeededToStart || len(options.VariablesNeededToStart) != 1 || options.Defaults["job_tags"] != "setup" {
+		t.Fatalf("Unexpected launch requirements %+v", options)
+	}
+}
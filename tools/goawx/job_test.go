@@ -0,0 +1,138 @@
+package awx_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+func TestJobServiceGetJobEventsFailures(t *testing.T) {
+	client := newRouteAWX(t, routes{
+		"GET /api/v2/jobs/9/job_events/": func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("event__in"); got != "runner_on_failed,runner_on_unreachable" {
+				t.Errorf("Unexpected event filter %q", got)
+			}
+			fmt.Fprint(w, `{"count": 2, "results": [
+				{"event": "runner_on_failed", "host_name": "web1", "task": "Restart",
+				 "event_data": {"task_action": "ansible.builtin.command", "ignore_errors": false,
+				  "res": {"cmd": ["systemctl", "restart", "nginx"], "rc": 1, "msg": "non-zero return code"}}},
+				{"event": "runner_on_unreachable", "host_name": "web2", "task": "Gathering Facts",
+				 "event_data": {"task_action": "gather_facts", "res": {"msg": ["Failed to connect", "timeout"]}}}
+			]}`)
+		},
+	})
+
+	events, _, err := client.JobService.GetJobEvents(9, map[string]string{
+		"event__in": awx.JobEventRunnerOnFailed + "," + awx.JobEventRunnerOnUnreachable,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].EventData.Res.Msg != "non-zero return code" || events[0].EventData.TaskAction != "ansible.builtin.command" {
+		t.Fatalf("Unexpected failing events %+v", events)
+	}
+	if msg, ok := events[1].EventData.Res.Msg.([]interface{}); !ok || len(msg) != 2 {
+		t.Fatalf("Expecting the list message of the unreachable event but got %+v", events[1].EventData.Res)
+	}
+}
+
+func TestJobServiceGetJobArtifacts(t *testing.T) {
+	client := newRouteAWX(t, routes{
+		"GET /api/v2/jobs/9/": reply(http.StatusOK, `{"id": 9, "status": "successful", "started": "2024-05-01T10:00:00Z", "finished": null,
+			"artifacts": {"release": "1.2.3", "hosts": ["web1", "web2"], "ports": {"http": 80}}}`),
+	})
+
+	job, err := client.JobService.GetJob(9, map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Artifacts["release"] != "1.2.3" || len(job.Artifacts["hosts"].([]interface{})) != 2 {
+		t.Fatalf("Unexpected artifacts %+v", job.Artifacts)
+	}
+	if ports, ok := job.Artifacts["ports"].(map[string]interface{}); !ok || ports["http"] != float64(80) {
+		t.Fatalf("Expecting nested artifacts but got %+v", job.Artifacts)
+	}
+	if job.Started.IsZero() || !job.Finished.IsZero() {
+		t.Fatalf("Unexpected job times %v and %v", job.Started, job.Finished)
+	}
+}
+
+func TestJobServiceCancelJob(t *testing.T) {
+	var canceled bool
+	client := newRouteAWX(t, routes{
+		"POST /api/v2/jobs/9/cancel/": func(w http.ResponseWriter, _ *http.Request) {
+			// AWX accepts the cancel without a body.
+			canceled = true
+			w.WriteHeader(http.StatusAccepted)
+		},
+	})
+
+	if _, err := client.JobService.CancelJob(9, map[string]interface{}{}, map[string]string{}); err != nil {
+		t.Fatal(err)
+	}
+	if !canceled {
+		t.Fatal("Expecting the job to be canceled")
+	}
+	if awx.IsJobFinished(awx.JobStatusRunning) || !awx.IsJobFinished(awx.JobStatusCanceled) {
+		t.Fatal("Unexpected terminal statuses")
+	}
+}
+
+func TestJobServiceRelaunch(t *testing.T) {
+	var relaunched map[string]interface{}
+	client := newRouteAWX(t, routes{
+		"GET /api/v2/jobs/9/relaunch/":  reply(http.StatusOK, `{"passwords_needed_to_start": ["ssh_password"], "retry_counts": {"all": 5, "failed": 2}}`),
+		"GET /api/v2/jobs/10/relaunch/": reply(http.StatusOK, `{"passwords_needed_to_start": [], "retry_counts": {"all": 5, "failed": 0}}`),
+		"POST /api/v2/jobs/9/relaunch/": func(w http.ResponseWriter, r *http.Request) {
+			if err := json.NewDecoder(r.Body).Decode(&relaunched); err != nil {
+				t.Error(err)
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": 11, "job": 11, "status": "pending"}`)
+		},
+	})
+
+	if _, err := client.JobService.Relaunch(9, &awx.JobRelaunchRequest{Hosts: awx.RelaunchHostsFailed}); err == nil ||
+		!strings.Contains(err.Error(), "ssh_password") {
+		t.Fatalf("Expecting the missing password to be reported but got %v", err)
+	}
+	if _, err := client.JobService.Relaunch(10, &awx.JobRelaunchRequest{Hosts: awx.RelaunchHostsFailed}); err == nil {
+		t.Fatal("Expecting a job without failed hosts not to be relaunched")
+	}
+
+	res, err := client.JobService.Relaunch(9, &awx.JobRelaunchRequest{
+		Hosts:               awx.RelaunchHostsFailed,
+		CredentialPasswords: map[string]string{"ssh_password": "secret"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ID != 11 {
+		t.Fatalf("Expecting the relaunched job 11 but got %+v", res)
+	}
+	if relaunched["hosts"] != awx.RelaunchHostsFailed || relaunched["job_type"] != nil {
+		t.Fatalf("Unexpected relaunch payload %v", relaunched)
+	}
+	if passwords, _ := relaunched["credential_passwords"].(map[string]interface{}); passwords["ssh_password"] != "secret" {
+		t.Fatalf("Expecting the credential passwords in the relaunch payload but got %v", relaunched)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	client := newRouteAWX(t, routes{
+		"GET /api/v2/jobs/9/": reply(http.StatusInternalServerError, `{"detail": "Server error."}`),
+		// AWX answers for jobs purged by cleanup_jobs.
+		"GET /api/v2/jobs/10/": reply(http.StatusNotFound, `{"detail": "Not found."}`),
+	})
+
+	if _, err := client.JobService.GetJob(10, map[string]string{}); !awx.IsNotFound(err) {
+		t.Fatalf("Expecting a not found error but got %v", err)
+	}
+	if _, err := client.JobService.GetJob(9, map[string]string{}); err == nil || awx.IsNotFound(err) {
+		t.Fatalf("Expecting a server error but got %v", err)
+	}
+}
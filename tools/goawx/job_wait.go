@@ -0,0 +1,172 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// JobOutcome is the terminal outcome of a job awaited with WaitForJob.
+type JobOutcome string
+
+// Enum of job outcomes, the terminal job statuses and the timeout of the wait.
+const (
+	JobOutcomeSuccessful JobOutcome = JobStatusSuccessful
+	JobOutcomeFailed     JobOutcome = JobStatusFailed
+	JobOutcomeError      JobOutcome = JobStatusError
+	JobOutcomeCanceled   JobOutcome = JobStatusCanceled
+	JobOutcomeTimeout    JobOutcome = "timeout"
+)
+
+const defaultJobWaitPollInterval = 3 * time.Second
+
+// JobWaitOptions configures WaitForJob.
+type JobWaitOptions struct {
+	// Timeout bounds the wait, no bound when 0 other than the context.
+	Timeout time.Duration
+	// PollInterval is the delay between two reads of the job, 3 seconds when 0.
+	PollInterval time.Duration
+}
+
+// JobWaitError is returned by WaitForJob and WaitForWorkflowJob when a job does not succeed.
+type JobWaitError struct {
+	Outcome JobOutcome
+	// ID, Status and Explanation are those of the job when it was last read.
+	ID          int
+	Status      string
+	Explanation string
+	// URL is the output page of the job in the AWX user interface.
+	URL string
+	// Err is the context error when the wait timed out.
+	Err error
+	// PollErr is the error of the last read of the job when it failed before the timeout.
+	PollErr error
+}
+
+func (e *JobWaitError) Error() string {
+	var b strings.Builder
+	switch {
+	case e.Outcome == JobOutcomeTimeout && e.Status == "":
+		fmt.Fprintf(&b, "job %d timed out", e.ID)
+	case e.Outcome == JobOutcomeTimeout:
+		fmt.Fprintf(&b, "job %d timed out while %s", e.ID, e.Status)
+	default:
+		fmt.Fprintf(&b, "job %d %s", e.ID, e.Outcome)
+	}
+	if e.Explanation != "" {
+		fmt.Fprintf(&b, ": %s", e.Explanation)
+	}
+	fmt.Fprintf(&b, " (%s)", e.URL)
+	if e.PollErr != nil {
+		fmt.Fprintf(&b, ", the last read of the job failed: %s", e.PollErr)
+	}
+	return b.String()
+}
+
+func (e *JobWaitError) Unwrap() error {
+	return e.Err
+}
+
+// IsJobFinished reports whether a job status is terminal.
+func IsJobFinished(status string) bool {
+	switch status {
+	case JobStatusSuccessful, JobStatusFailed, JobStatusError, JobStatusCanceled:
+		return true
+	}
+	return false
+}
+
+// outputURL returns the output page of a unified job in the AWX user interface, kind being
+// the kind of job in the path, such as playbook or workflow.
+func outputURL(client *Client, kind string, id int) string {
+	return fmt.Sprintf("%s/#/jobs/%s/%d/output", strings.TrimSuffix(client.BaseURL, "/"), kind, id)
+}
+
+// JobOutputURL returns the output page of a job in the AWX user interface.
+func (j *JobService) JobOutputURL(id int) string {
+	return outputURL(j.client, "playbook", id)
+}
+
+// WaitForJob polls a job until it reaches a terminal status. It returns the job when it is
+// successful, and a *JobWaitError with the outcome when it failed, errored, was canceled or
+// the wait timed out.
+func (j *JobService) WaitForJob(ctx context.Context, id int, opts *JobWaitOptions) (*Job, error) {
+	var job *Job
+	err := waitForUnifiedJob(ctx, id, j.JobOutputURL(id), opts, func() (string, string, error) {
+		got, err := j.GetJob(id, map[string]string{})
+		if err != nil {
+			return "", "", err
+		}
+		job = got
+		return job.Status, job.JobExplanation, nil
+	})
+	return job, err
+}
+
+// isPermanentPollError reports whether a failed read of a job can not succeed on a later
+// poll, the job being gone or the credentials refused.
+func isPermanentPollError(err error) bool {
+	var respErr *ResponseError
+	if !errors.As(err, &respErr) {
+		return false
+	}
+	switch respErr.StatusCode {
+	case http.StatusNotFound, http.StatusUnauthorized, http.StatusForbidden:
+		return true
+	}
+	return false
+}
+
+// waitForUnifiedJob polls the status and explanation of a unified job with get until it
+// reaches a terminal status. Failed reads, such as a bad gateway while AWX restarts, are
+// retried until the timeout unless they are permanent.
+func waitForUnifiedJob(ctx context.Context, id int, url string, opts *JobWaitOptions, get func() (string, string, error)) error {
+	if opts == nil {
+		opts = &JobWaitOptions{}
+	}
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = defaultJobWaitPollInterval
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	var status, explanation string
+	var pollErr error
+	for {
+		s, e, err := get()
+		if err != nil && isPermanentPollError(err) {
+			return err
+		}
+		pollErr = err
+		if err == nil {
+			status, explanation = s, e
+			switch status {
+			case JobStatusSuccessful:
+				return nil
+			case JobStatusFailed, JobStatusError, JobStatusCanceled:
+				return &JobWaitError{Outcome: JobOutcome(status), ID: id, Status: status, Explanation: explanation, URL: url}
+			}
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return &JobWaitError{
+					Outcome: JobOutcomeTimeout, ID: id, Status: status, Explanation: explanation, URL: url,
+					Err: ctx.Err(), PollErr: pollErr,
+				}
+			}
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
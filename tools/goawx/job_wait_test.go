@@ -0,0 +1,126 @@
+package awx_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+// newJobStatusAWX serves job 9 going through statuses, the last one repeating.
+func newJobStatusAWX(t *testing.T, statuses ...string) *awx.AWX {
+	t.Helper()
+	var mu sync.Mutex
+	return newRouteAWX(t, routes{
+		"GET /api/v2/jobs/9/": func(w http.ResponseWriter, _ *http.Request) {
+			mu.Lock()
+			status := statuses[0]
+			if len(statuses) > 1 {
+				statuses = statuses[1:]
+			}
+			mu.Unlock()
+			fmt.Fprintf(w, `{"id": 9, "status": %q, "job_explanation": "explained %s"}`, status, status)
+		},
+	})
+}
+
+func TestJobServiceWaitForJob(t *testing.T) {
+	opts := &awx.JobWaitOptions{PollInterval: time.Millisecond}
+
+	client := newJobStatusAWX(t, awx.JobStatusPending, awx.JobStatusRunning, awx.JobStatusSuccessful)
+	job, err := client.JobService.WaitForJob(context.Background(), 9, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Status != awx.JobStatusSuccessful {
+		t.Fatalf("Expecting a successful job but got %+v", job)
+	}
+
+	for _, outcome := range []awx.JobOutcome{awx.JobOutcomeFailed, awx.JobOutcomeError, awx.JobOutcomeCanceled} {
+		client := newJobStatusAWX(t, awx.JobStatusWaiting, string(outcome))
+		_, err := client.JobService.WaitForJob(context.Background(), 9, opts)
+		var waitErr *awx.JobWaitError
+		if !errors.As(err, &waitErr) || waitErr.Outcome != outcome {
+			t.Fatalf("Expecting a %s outcome but got %v", outcome, err)
+		}
+		if !strings.Contains(err.Error(), "explained "+string(outcome)) || !strings.HasSuffix(waitErr.URL, "/#/jobs/playbook/9/output") {
+			t.Fatalf("Expecting the job explanation and URL in %q", err)
+		}
+	}
+}
+
+func TestJobServiceWaitForJobTimeout(t *testing.T) {
+	client := newJobStatusAWX(t, awx.JobStatusRunning)
+	_, err := client.JobService.WaitForJob(context.Background(), 9, &awx.JobWaitOptions{
+		Timeout:      20 * time.Millisecond,
+		PollInterval: time.Millisecond,
+	})
+	var waitErr *awx.JobWaitError
+	if !errors.As(err, &waitErr) || waitErr.Outcome != awx.JobOutcomeTimeout || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expecting a timeout outcome but got %v", err)
+	}
+}
+
+func TestJobServiceWaitForJobRetriesFailedPolls(t *testing.T) {
+	var mu sync.Mutex
+	polls := 0
+	client := newRouteAWX(t, routes{
+		"GET /api/v2/jobs/9/": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			polls++
+			poll := polls
+			mu.Unlock()
+			switch poll {
+			case 1:
+				fmt.Fprint(w, `{"id": 9, "status": "running"}`)
+			case 2:
+				reply(http.StatusBadGateway, `{"detail": "Bad Gateway"}`)(w, r)
+			default:
+				fmt.Fprint(w, `{"id": 9, "status": "successful"}`)
+			}
+		},
+	})
+	job, err := client.JobService.WaitForJob(context.Background(), 9, &awx.JobWaitOptions{PollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Status != awx.JobStatusSuccessful || polls != 3 {
+		t.Fatalf("Expecting a successful job after 3 polls but got %+v after %d", job, polls)
+	}
+}
+
+func TestJobServiceWaitForJobPollErrors(t *testing.T) {
+	opts := &awx.JobWaitOptions{Timeout: 20 * time.Millisecond, PollInterval: time.Millisecond}
+
+	for _, status := range []int{http.StatusNotFound, http.StatusUnauthorized, http.StatusForbidden} {
+		polls := 0
+		client := newRouteAWX(t, routes{
+			"GET /api/v2/jobs/9/": func(w http.ResponseWriter, r *http.Request) {
+				polls++
+				reply(status, `{"detail": "Nope."}`)(w, r)
+			},
+		})
+		_, err := client.JobService.WaitForJob(context.Background(), 9, opts)
+		var respErr *awx.ResponseError
+		if !errors.As(err, &respErr) || respErr.StatusCode != status || polls != 1 {
+			t.Fatalf("Expecting the %d response to end the wait at once but got %v after %d polls", status, err, polls)
+		}
+	}
+
+	client := newRouteAWX(t, routes{"GET /api/v2/jobs/9/": reply(http.StatusBadGateway, `{"detail": "Bad Gateway"}`)})
+	_, err := client.JobService.WaitForJob(context.Background(), 9, opts)
+	var waitErr *awx.JobWaitError
+	if !errors.As(err, &waitErr) || waitErr.Outcome != awx.JobOutcomeTimeout || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expecting a timeout outcome but got %v", err)
+	}
+	var respErr *awx.ResponseError
+	if !errors.As(waitErr.PollErr, &respErr) || respErr.StatusCode != http.StatusBadGateway || !strings.Contains(err.Error(), "502") {
+		t.Fatalf("Expecting the last poll error in %q", err)
+	}
+}
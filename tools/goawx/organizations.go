@@ -137,6 +137,29 @@ func (p *OrganizationsService) DeleteOrganization(id int) (*Organization, error)
 	return result, nil
 }
 
+// ListGalaxyCredentials shows the Galaxy credentials associated to an awx organization.
+func (p *OrganizationsService) ListGalaxyCredentials(id int, params map[string]string) ([]*Credential, *ListCredentialsResponse, error) {
+	result := new(ListCredentialsResponse)
+	endpoint := fmt.Sprintf("%s%d/galaxy_credentials/", organizationsAPIEndpoint, id)
+	resp, err := p.client.Requester.GetJSON(endpoint, result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
+
 // DisAssociateGalaxyCredentials remove Credentials form an awx job template.
 func (p *OrganizationsService) DisAssociateGalaxyCredentials(id int, data map[string]interface{}, _ map[string]string) (*Organization, error) {
 	result := new(Organization)
@@ -169,7 +169,8 @@ func (r *Requester) ReadJSONResponse(response *http.Response, responseStruct int
 		}
 	}()
 
-	if err := json.NewDecoder(response.Body).Decode(responseStruct); err != nil {
+	// Some endpoints, such as job cancel, accept a request with an empty body.
+	if err := json.NewDecoder(response.Body).Decode(responseStruct); err != nil && !errors.Is(err, io.EOF) {
 		return response, err
 	}
 
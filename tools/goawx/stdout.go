@@ -0,0 +1,126 @@
+package awx
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Enum of the formats of a job output.
+const (
+	StdoutFormatTxt          = "txt"
+	StdoutFormatAnsi         = "ansi"
+	StdoutFormatJSON         = "json"
+	StdoutFormatTxtDownload  = "txt_download"
+	StdoutFormatAnsiDownload = "ansi_download"
+)
+
+// StdoutOptions selects the format and the lines of a job output.
+type StdoutOptions struct {
+	// Format is one of the StdoutFormat constants, txt when empty.
+	Format string
+	// StartLine is the first line to read, counting from 0.
+	StartLine int
+	// EndLine is the line after the last one to read, up to the end when 0.
+	EndLine int
+}
+
+// Stdout streams the output of a job. The caller must close the returned reader.
+func (j *JobService) Stdout(id int, opts *StdoutOptions) (io.ReadCloser, error) {
+	return unifiedJobStdout(j.client, fmt.Sprintf("%s%d/stdout/", jobAPIEndpoint, id), opts)
+}
+
+// ProjectUpdateStdout streams the output of a project update. The caller must close the returned reader.
+func (p *ProjectUpdatesService) ProjectUpdateStdout(id int, opts *StdoutOptions) (io.ReadCloser, error) {
+	return unifiedJobStdout(p.client, fmt.Sprintf("%s%d/stdout/", projectUpdatesAPIEndpoint, id), opts)
+}
+
+// unifiedJobStdout streams the stdout endpoint of a unified job. AWX only limits the lines of
+// the json format, those of the text formats are skipped while reading so that large outputs
+// are never held in memory.
+func unifiedJobStdout(client *Client, endpoint string, opts *StdoutOptions) (io.ReadCloser, error) {
+	if opts == nil {
+		opts = &StdoutOptions{}
+	}
+	if opts.StartLine < 0 || opts.EndLine < 0 || (opts.EndLine > 0 && opts.EndLine < opts.StartLine) {
+		return nil, fmt.Errorf("invalid stdout line range %d to %d", opts.StartLine, opts.EndLine)
+	}
+	format := opts.Format
+	if format == "" {
+		format = StdoutFormatTxt
+	}
+
+	params := map[string]string{"format": format}
+	if format == StdoutFormatJSON {
+		params["start_line"] = strconv.Itoa(opts.StartLine)
+		if opts.EndLine > 0 {
+			params["end_line"] = strconv.Itoa(opts.EndLine)
+		}
+	}
+
+	resp, err := client.Requester.Do(NewAPIRequest("GET", endpoint, nil), nil, params)
+	if err != nil {
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		return nil, err
+	}
+	if err := CheckResponse(resp); err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+
+	if format == StdoutFormatJSON || (opts.StartLine == 0 && opts.EndLine == 0) {
+		return resp.Body, nil
+	}
+	return &lineRangeReader{
+		body:  resp.Body,
+		lines: bufio.NewReader(resp.Body),
+		start: opts.StartLine,
+		end:   opts.EndLine,
+	}, nil
+}
+
+// lineRangeReader reads the lines from start to end, excluded, of a body.
+type lineRangeReader struct {
+	body    io.Closer
+	lines   *bufio.Reader
+	line    int
+	start   int
+	end     int
+	pending []byte
+	err     error
+}
+
+func (l *lineRangeReader) Read(p []byte) (int, error) {
+	for len(l.pending) == 0 {
+		if l.err != nil {
+			return 0, l.err
+		}
+		if l.end > 0 && l.line >= l.end {
+			return 0, io.EOF
+		}
+
+		// The chunk stays valid until the next read, which only happens once it is consumed.
+		chunk, err := l.lines.ReadSlice('\n')
+		if l.line >= l.start {
+			l.pending = chunk
+		}
+		if len(chunk) > 0 && chunk[len(chunk)-1] == '\n' {
+			l.line++
+		}
+		if err != nil && !errors.Is(err, bufio.ErrBufferFull) {
+			l.err = err
+		}
+	}
+
+	n := copy(p, l.pending)
+	l.pending = l.pending[n:]
+	return n, nil
+}
+
+func (l *lineRangeReader) Close() error {
+	return l.body.Close()
+}
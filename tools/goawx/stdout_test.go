@@ -0,0 +1,81 @@
+package awx_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+// newStdoutAWX serves the output of job 9 and project update 4, recording the query of each
+// request, while job 10 is missing.
+func newStdoutAWX(t *testing.T, queries *[]string) *awx.AWX {
+	t.Helper()
+	stdout := func(w http.ResponseWriter, r *http.Request) {
+		*queries = append(*queries, r.URL.RawQuery)
+		if r.URL.Query().Get("format") == awx.StdoutFormatJSON {
+			fmt.Fprint(w, `{"range": {"start": 1, "end": 2}, "content": "TASK [ping]\n"}`)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprint(w, "PLAY [all]\nTASK [ping]\nok: [web1]\nPLAY RECAP\nweb1 : ok=1")
+	}
+	return newRouteAWX(t, routes{
+		"GET /api/v2/jobs/9/stdout/":            stdout,
+		"GET /api/v2/project_updates/4/stdout/": stdout,
+		"GET /api/v2/jobs/10/stdout/":           reply(http.StatusNotFound, `{"detail": "Not found."}`),
+	})
+}
+
+func readStdout(t *testing.T, r io.ReadCloser, err error) string {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	b, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestJobServiceStdout(t *testing.T) {
+	var queries []string
+	client := newStdoutAWX(t, &queries)
+
+	r, err := client.JobService.Stdout(9, nil)
+	if got := readStdout(t, r, err); !strings.HasPrefix(got, "PLAY [all]\n") || !strings.HasSuffix(got, "ok=1") {
+		t.Fatalf("Unexpected output %q", got)
+	}
+
+	r, err = client.JobService.Stdout(9, &awx.StdoutOptions{Format: awx.StdoutFormatTxtDownload, StartLine: 1, EndLine: 3})
+	if got := readStdout(t, r, err); got != "TASK [ping]\nok: [web1]\n" {
+		t.Fatalf("Unexpected lines 1 to 3 %q", got)
+	}
+
+	r, err = client.JobService.Stdout(9, &awx.StdoutOptions{StartLine: 3})
+	if got := readStdout(t, r, err); got != "PLAY RECAP\nweb1 : ok=1" {
+		t.Fatalf("Unexpected lines from 3 %q", got)
+	}
+
+	r, err = client.ProjectUpdatesService.ProjectUpdateStdout(4, &awx.StdoutOptions{Format: awx.StdoutFormatJSON, StartLine: 1, EndLine: 2})
+	if got := readStdout(t, r, err); !strings.Contains(got, `"content": "TASK [ping]\n"`) {
+		t.Fatalf("Unexpected json output %q", got)
+	}
+
+	want := []string{"format=txt", "format=txt_download", "format=txt", "end_line=2&format=json&start_line=1"}
+	if strings.Join(queries, " ") != strings.Join(want, " ") {
+		t.Fatalf("Expecting queries %v but got %v", want, queries)
+	}
+
+	if _, err := client.JobService.Stdout(10, nil); err == nil {
+		t.Fatal("Expecting an error for a missing job")
+	}
+	if _, err := client.JobService.Stdout(9, &awx.StdoutOptions{StartLine: 3, EndLine: 1}); err == nil {
+		t.Fatal("Expecting an error for an invalid line range")
+	}
+}
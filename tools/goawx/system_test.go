@@ -41,6 +41,11 @@ func TestMain(m *testing.M) {
 	awxUsername = os.Getenv("GOAWX_USERNAME")
 	awxPassword = os.Getenv("GOAWX_PASSWORD")
 
+	// Without an AWX to talk to, only the tests running against a fake server are run.
+	if awxHostname == "" && awxUsername == "" && awxPassword == "" {
+		os.Exit(m.Run())
+	}
+
 	if awxHostname == "" {
 		log.Fatal("no AWX hostname provided")
 	}
@@ -61,7 +66,16 @@ func TestMain(m *testing.M) {
 	os.Exit(m.Run())
 }
 
+// requireAWX skips tests needing a real AWX when none is configured.
+func requireAWX(t *testing.T) {
+	t.Helper()
+	if awxClient == nil {
+		t.Skip("GOAWX_HOSTNAME, GOAWX_USERNAME and GOAWX_PASSWORD are not set")
+	}
+}
+
 func TestCredentialsService(t *testing.T) {
+	requireAWX(t)
 	var createResponse *awx.Credential
 
 	for _, tt := range credentialsServiceTestTable {
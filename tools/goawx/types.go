@@ -296,16 +296,40 @@ type Credential struct {
 	CredentialTypeID int                    `json:"credential_type"`
 	Inputs           map[string]interface{} `json:"inputs"`
 	SummaryFields    *Summary               `json:"summary_fields"`
+	Modified         time.Time              `json:"modified"`
 }
 
 // CredentialType represents the awx api credential type.
 type CredentialType struct {
-	ID          int         `json:"ID"`
-	Name        string      `json:"name"`
-	Description string      `json:"description"`
-	Kind        string      `json:"kind"`
-	Inputs      interface{} `json:"inputs"`
-	Injectors   interface{} `json:"injectors"`
+	ID             int         `json:"ID"`
+	Name           string      `json:"name"`
+	Description    string      `json:"description"`
+	Kind           string      `json:"kind"`
+	Namespace      string      `json:"namespace"`
+	Managed        bool        `json:"managed"`
+	ManagedByTower bool        `json:"managed_by_tower"`
+	Inputs         interface{} `json:"inputs"`
+	Injectors      interface{} `json:"injectors"`
+}
+
+// CredentialTypeInputs represents the inputs schema of an awx api credential type.
+type CredentialTypeInputs struct {
+	Fields   []*CredentialTypeField `json:"fields"`
+	Metadata []*CredentialTypeField `json:"metadata"`
+	Required []string               `json:"required"`
+}
+
+// CredentialTypeField represents a field of the inputs schema of an awx api credential type.
+type CredentialTypeField struct {
+	ID        string      `json:"id"`
+	Label     string      `json:"label"`
+	Type      string      `json:"type"`
+	Secret    bool        `json:"secret"`
+	Multiline bool        `json:"multiline"`
+	Format    string      `json:"format"`
+	Choices   []string    `json:"choices"`
+	Default   interface{} `json:"default"`
+	HelpText  string      `json:"help_text"`
 }
 
 // CredentialInputSource represents the awx api input source.
@@ -460,128 +484,175 @@ type SurveySpec struct {
 	QuestionDescription string `json:"question_description"`
 }
 
+// JobTemplateLaunchOptions represents what a job or workflow job template accepts when it is
+// launched, as returned by the launch endpoint.
+type JobTemplateLaunchOptions struct {
+	CanStartWithoutUserInput        bool                   `json:"can_start_without_user_input"`
+	PasswordsNeededToStart          []string               `json:"passwords_needed_to_start"`
+	VariablesNeededToStart          []string               `json:"variables_needed_to_start"`
+	CredentialNeededToStart         bool                   `json:"credential_needed_to_start"`
+	InventoryNeededToStart          bool                   `json:"inventory_needed_to_start"`
+	SurveyEnabled                   bool                   `json:"survey_enabled"`
+	AskScmBranchOnLaunch            bool                   `json:"ask_scm_branch_on_launch"`
+	AskVariablesOnLaunch            bool                   `json:"ask_variables_on_launch"`
+	AskTagsOnLaunch                 bool                   `json:"ask_tags_on_launch"`
+	AskDiffModeOnLaunch             bool                   `json:"ask_diff_mode_on_launch"`
+	AskSkipTagsOnLaunch             bool                   `json:"ask_skip_tags_on_launch"`
+	AskJobTypeOnLaunch              bool                   `json:"ask_job_type_on_launch"`
+	AskLimitOnLaunch                bool                   `json:"ask_limit_on_launch"`
+	AskVerbosityOnLaunch            bool                   `json:"ask_verbosity_on_launch"`
+	AskInventoryOnLaunch            bool                   `json:"ask_inventory_on_launch"`
+	AskCredentialOnLaunch           bool                   `json:"ask_credential_on_launch"`
+	AskExecutionEnvironmentOnLaunch bool                   `json:"ask_execution_environment_on_launch"`
+	AskLabelsOnLaunch               bool                   `json:"ask_labels_on_launch"`
+	AskForksOnLaunch                bool                   `json:"ask_forks_on_launch"`
+	AskJobSliceCountOnLaunch        bool                   `json:"ask_job_slice_count_on_launch"`
+	AskTimeoutOnLaunch              bool                   `json:"ask_timeout_on_launch"`
+	AskInstanceGroupsOnLaunch       bool                   `json:"ask_instance_groups_on_launch"`
+	Defaults                        map[string]interface{} `json:"defaults"`
+}
+
+// JobRelaunchOptions represents what a job needs to be relaunched, as returned by the relaunch endpoint.
+type JobRelaunchOptions struct {
+	PasswordsNeededToStart []string          `json:"passwords_needed_to_start"`
+	RetryCounts            *JobRelaunchCount `json:"retry_counts"`
+}
+
+// JobRelaunchCount represents the number of hosts a job is relaunched on, per relaunch hosts.
+type JobRelaunchCount struct {
+	All    int `json:"all"`
+	Failed int `json:"failed"`
+}
+
+// JobRelaunchRequest represents the awx api job relaunch, on all or the failed hosts of the job.
+type JobRelaunchRequest struct {
+	Hosts               string            `json:"hosts,omitempty"`
+	JobType             string            `json:"job_type,omitempty"`
+	CredentialPasswords map[string]string `json:"credential_passwords,omitempty"`
+}
+
 // JobLaunch represents the awx api job launch.
 //
 //nolint:maligned
 type JobLaunch struct {
-	Job                     int               `json:"job"`
-	IgnoredFields           map[string]string `json:"ignored_fields"`
-	ID                      int               `json:"id"`
-	Type                    string            `json:"type"`
-	URL                     string            `json:"url"`
-	Related                 *Related          `json:"related"`
-	SummaryFields           *Summary          `json:"summary_fields"`
-	Created                 time.Time         `json:"created"`
-	Modified                time.Time         `json:"modified"`
-	Name                    string            `json:"name"`
-	Description             string            `json:"description"`
-	JobType                 string            `json:"job_type"`
-	Inventory               int               `json:"inventory"`
-	Project                 int               `json:"project"`
-	Playbook                string            `json:"playbook"`
-	Forks                   int               `json:"forks"`
-	Limit                   string            `json:"limit"`
-	Verbosity               int               `json:"verbosity"`
-	ExtraVars               string            `json:"extra_vars"`
-	JobTags                 string            `json:"job_tags"`
-	ForceHandlers           bool              `json:"force_handlers"`
-	SkipTags                string            `json:"skip_tags"`
-	StartAtTask             string            `json:"start_at_task"`
-	Timeout                 int               `json:"timeout"`
-	UseFactCache            bool              `json:"use_fact_cache"`
-	UnifiedJobTemplate      int               `json:"unified_job_template"`
-	LaunchType              string            `json:"launch_type"`
-	Status                  string            `json:"status"`
-	Failed                  bool              `json:"failed"`
-	Started                 interface{}       `json:"started"`
-	Finished                interface{}       `json:"finished"`
-	Elapsed                 float64           `json:"elapsed"`
-	JobArgs                 string            `json:"job_args"`
-	JobCwd                  string            `json:"job_cwd"`
-	JobEnv                  map[string]string `json:"job_env"`
-	JobExplanation          string            `json:"job_explanation"`
-	ExecutionNode           string            `json:"execution_node"`
-	ResultTraceback         string            `json:"result_traceback"`
-	EventProcessingFinished bool              `json:"event_processing_finished"`
-	JobTemplate             int               `json:"job_template"`
-	PasswordsNeededToStart  []interface{}     `json:"passwords_needed_to_start"`
-	AskDiffModeOnLaunch     bool              `json:"ask_diff_mode_on_launch"`
-	AskVariablesOnLaunch    bool              `json:"ask_variables_on_launch"`
-	AskLimitOnLaunch        bool              `json:"ask_limit_on_launch"`
-	AskTagsOnLaunch         bool              `json:"ask_tags_on_launch"`
-	AskSkipTagsOnLaunch     bool              `json:"ask_skip_tags_on_launch"`
-	AskJobTypeOnLaunch      bool              `json:"ask_job_type_on_launch"`
-	AskVerbosityOnLaunch    bool              `json:"ask_verbosity_on_launch"`
-	AskInventoryOnLaunch    bool              `json:"ask_inventory_on_launch"`
-	AskCredentialOnLaunch   bool              `json:"ask_credential_on_launch"`
-	AllowSimultaneous       bool              `json:"allow_simultaneous"`
-	Artifacts               map[string]string `json:"artifacts"`
-	ScmRevision             string            `json:"scm_revision"`
-	InstanceGroup           interface{}       `json:"instance_group"`
-	DiffMode                bool              `json:"diff_mode"`
-	Credential              int               `json:"credential"`
-	VaultCredential         interface{}       `json:"vault_credential"`
+	Job                     int                    `json:"job"`
+	IgnoredFields           map[string]interface{} `json:"ignored_fields"`
+	ID                      int                    `json:"id"`
+	Type                    string                 `json:"type"`
+	URL                     string                 `json:"url"`
+	Related                 *Related               `json:"related"`
+	SummaryFields           *Summary               `json:"summary_fields"`
+	Created                 time.Time              `json:"created"`
+	Modified                time.Time              `json:"modified"`
+	Name                    string                 `json:"name"`
+	Description             string                 `json:"description"`
+	JobType                 string                 `json:"job_type"`
+	Inventory               int                    `json:"inventory"`
+	Project                 int                    `json:"project"`
+	Playbook                string                 `json:"playbook"`
+	Forks                   int                    `json:"forks"`
+	Limit                   string                 `json:"limit"`
+	Verbosity               int                    `json:"verbosity"`
+	ExtraVars               string                 `json:"extra_vars"`
+	JobTags                 string                 `json:"job_tags"`
+	ForceHandlers           bool                   `json:"force_handlers"`
+	SkipTags                string                 `json:"skip_tags"`
+	StartAtTask             string                 `json:"start_at_task"`
+	Timeout                 int                    `json:"timeout"`
+	UseFactCache            bool                   `json:"use_fact_cache"`
+	UnifiedJobTemplate      int                    `json:"unified_job_template"`
+	LaunchType              string                 `json:"launch_type"`
+	Status                  string                 `json:"status"`
+	Failed                  bool                   `json:"failed"`
+	Started                 interface{}            `json:"started"`
+	Finished                interface{}            `json:"finished"`
+	Elapsed                 float64                `json:"elapsed"`
+	JobArgs                 string                 `json:"job_args"`
+	JobCwd                  string                 `json:"job_cwd"`
+	JobEnv                  map[string]string      `json:"job_env"`
+	JobExplanation          string                 `json:"job_explanation"`
+	ExecutionNode           string                 `json:"execution_node"`
+	ResultTraceback         string                 `json:"result_traceback"`
+	EventProcessingFinished bool                   `json:"event_processing_finished"`
+	JobTemplate             int                    `json:"job_template"`
+	PasswordsNeededToStart  []interface{}          `json:"passwords_needed_to_start"`
+	AskDiffModeOnLaunch     bool                   `json:"ask_diff_mode_on_launch"`
+	AskVariablesOnLaunch    bool                   `json:"ask_variables_on_launch"`
+	AskLimitOnLaunch        bool                   `json:"ask_limit_on_launch"`
+	AskTagsOnLaunch         bool                   `json:"ask_tags_on_launch"`
+	AskSkipTagsOnLaunch     bool                   `json:"ask_skip_tags_on_launch"`
+	AskJobTypeOnLaunch      bool                   `json:"ask_job_type_on_launch"`
+	AskVerbosityOnLaunch    bool                   `json:"ask_verbosity_on_launch"`
+	AskInventoryOnLaunch    bool                   `json:"ask_inventory_on_launch"`
+	AskCredentialOnLaunch   bool                   `json:"ask_credential_on_launch"`
+	AllowSimultaneous       bool                   `json:"allow_simultaneous"`
+	Artifacts               map[string]interface{} `json:"artifacts"`
+	ScmRevision             string                 `json:"scm_revision"`
+	InstanceGroup           interface{}            `json:"instance_group"`
+	DiffMode                bool                   `json:"diff_mode"`
+	Credential              int                    `json:"credential"`
+	VaultCredential         interface{}            `json:"vault_credential"`
 }
 
 // Job represents the awx api job.
 //
 //nolint:maligned
 type Job struct {
-	ID                      int               `json:"id"`
-	Type                    string            `json:"type"`
-	URL                     string            `json:"url"`
-	Related                 *Related          `json:"related"`
-	SummaryFields           *Summary          `json:"summary_fields"`
-	Created                 time.Time         `json:"created"`
-	Modified                time.Time         `json:"modified"`
-	Name                    string            `json:"name"`
-	Description             string            `json:"description"`
-	JobType                 string            `json:"job_type"`
-	Inventory               int               `json:"inventory"`
-	Project                 int               `json:"project"`
-	Playbook                string            `json:"playbook"`
-	Forks                   int               `json:"forks"`
-	Limit                   string            `json:"limit"`
-	Verbosity               int               `json:"verbosity"`
-	ExtraVars               string            `json:"extra_vars"`
-	JobTags                 string            `json:"job_tags"`
-	ForceHandlers           bool              `json:"force_handlers"`
-	SkipTags                string            `json:"skip_tags"`
-	StartAtTask             string            `json:"start_at_task"`
-	Timeout                 int               `json:"timeout"`
-	UseFactCache            bool              `json:"use_fact_cache"`
-	UnifiedJobTemplate      int               `json:"unified_job_template"`
-	LaunchType              string            `json:"launch_type"`
-	Status                  string            `json:"status"`
-	Failed                  bool              `json:"failed"`
-	Started                 time.Time         `json:"started"`
-	Finished                time.Time         `json:"finished"`
-	Elapsed                 float64           `json:"elapsed"`
-	JobArgs                 string            `json:"job_args"`
-	JobCwd                  string            `json:"job_cwd"`
-	JobEnv                  map[string]string `json:"job_env"`
-	JobExplanation          string            `json:"job_explanation"`
-	ExecutionNode           string            `json:"execution_node"`
-	ResultTraceback         string            `json:"result_traceback"`
-	EventProcessingFinished bool              `json:"event_processing_finished"`
-	JobTemplate             int               `json:"job_template"`
-	PasswordsNeededToStart  []interface{}     `json:"passwords_needed_to_start"`
-	AskDiffModeOnLaunch     bool              `json:"ask_diff_mode_on_launch"`
-	AskVariablesOnLaunch    bool              `json:"ask_variables_on_launch"`
-	AskLimitOnLaunch        bool              `json:"ask_limit_on_launch"`
-	AskTagsOnLaunch         bool              `json:"ask_tags_on_launch"`
-	AskSkipTagsOnLaunch     bool              `json:"ask_skip_tags_on_launch"`
-	AskJobTypeOnLaunch      bool              `json:"ask_job_type_on_launch"`
-	AskVerbosityOnLaunch    bool              `json:"ask_verbosity_on_launch"`
-	AskInventoryOnLaunch    bool              `json:"ask_inventory_on_launch"`
-	AskCredentialOnLaunch   bool              `json:"ask_credential_on_launch"`
-	AllowSimultaneous       bool              `json:"allow_simultaneous"`
-	Artifacts               map[string]string `json:"artifacts"`
-	ScmRevision             string            `json:"scm_revision"`
-	InstanceGroup           int               `json:"instance_group"`
-	DiffMode                bool              `json:"diff_mode"`
-	Credential              *Credential       `json:"credential"`
-	VaultCredential         interface{}       `json:"vault_credential"`
+	ID                      int                    `json:"id"`
+	Type                    string                 `json:"type"`
+	URL                     string                 `json:"url"`
+	Related                 *Related               `json:"related"`
+	SummaryFields           *Summary               `json:"summary_fields"`
+	Created                 time.Time              `json:"created"`
+	Modified                time.Time              `json:"modified"`
+	Name                    string                 `json:"name"`
+	Description             string                 `json:"description"`
+	JobType                 string                 `json:"job_type"`
+	Inventory               int                    `json:"inventory"`
+	Project                 int                    `json:"project"`
+	Playbook                string                 `json:"playbook"`
+	Forks                   int                    `json:"forks"`
+	Limit                   string                 `json:"limit"`
+	Verbosity               int                    `json:"verbosity"`
+	ExtraVars               string                 `json:"extra_vars"`
+	JobTags                 string                 `json:"job_tags"`
+	ForceHandlers           bool                   `json:"force_handlers"`
+	SkipTags                string                 `json:"skip_tags"`
+	StartAtTask             string                 `json:"start_at_task"`
+	Timeout                 int                    `json:"timeout"`
+	UseFactCache            bool                   `json:"use_fact_cache"`
+	UnifiedJobTemplate      int                    `json:"unified_job_template"`
+	LaunchType              string                 `json:"launch_type"`
+	Status                  string                 `json:"status"`
+	Failed                  bool                   `json:"failed"`
+	Started                 time.Time              `json:"started"`
+	Finished                time.Time              `json:"finished"`
+	Elapsed                 float64                `json:"elapsed"`
+	JobArgs                 string                 `json:"job_args"`
+	JobCwd                  string                 `json:"job_cwd"`
+	JobEnv                  map[string]string      `json:"job_env"`
+	JobExplanation          string                 `json:"job_explanation"`
+	ExecutionNode           string                 `json:"execution_node"`
+	ResultTraceback         string                 `json:"result_traceback"`
+	EventProcessingFinished bool                   `json:"event_processing_finished"`
+	JobTemplate             int                    `json:"job_template"`
+	PasswordsNeededToStart  []interface{}          `json:"passwords_needed_to_start"`
+	AskDiffModeOnLaunch     bool                   `json:"ask_diff_mode_on_launch"`
+	AskVariablesOnLaunch    bool                   `json:"ask_variables_on_launch"`
+	AskLimitOnLaunch        bool                   `json:"ask_limit_on_launch"`
+	AskTagsOnLaunch         bool                   `json:"ask_tags_on_launch"`
+	AskSkipTagsOnLaunch     bool                   `json:"ask_skip_tags_on_launch"`
+	AskJobTypeOnLaunch      bool                   `json:"ask_job_type_on_launch"`
+	AskVerbosityOnLaunch    bool                   `json:"ask_verbosity_on_launch"`
+	AskInventoryOnLaunch    bool                   `json:"ask_inventory_on_launch"`
+	AskCredentialOnLaunch   bool                   `json:"ask_credential_on_launch"`
+	AllowSimultaneous       bool                   `json:"allow_simultaneous"`
+	Artifacts               map[string]interface{} `json:"artifacts"`
+	ScmRevision             string                 `json:"scm_revision"`
+	InstanceGroup           int                    `json:"instance_group"`
+	DiffMode                bool                   `json:"diff_mode"`
+	Credential              *Credential            `json:"credential"`
+	VaultCredential         interface{}            `json:"vault_credential"`
 }
 
 // HostSummaryHost represents the awx api host summary host fields.
@@ -662,7 +733,8 @@ type EventRes struct {
 	End           string           `json:"end"`
 	AnsibleNoLog  bool             `json:"_ansible_no_log"`
 	Stdout        string           `json:"stdout"`
-	Cmd           string           `json:"cmd"`
+	Cmd           interface{}      `json:"cmd"`
+	Msg           interface{}      `json:"msg"`
 	Start         string           `json:"start"`
 	Delta         string           `json:"delta"`
 	Stderr        string           `json:"stderr"`
@@ -690,6 +762,7 @@ type EventData struct {
 	Host         string      `json:"host"`
 	Role         string      `json:"role"`
 	TaskPath     string      `json:"task_path"`
+	IgnoreErrors bool        `json:"ignore_errors"`
 }
 
 // JobEvent represents the awx api job event.
@@ -935,6 +1008,108 @@ type WorkflowJobTemplateNode struct {
 	Identifier             string    `json:"identifier"`
 }
 
+// AdHocCommand represents the awx api ad hoc command, a run of a single module on an inventory.
+type AdHocCommand struct {
+	ID                   int       `json:"id"`
+	Type                 string    `json:"type"`
+	URL                  string    `json:"url"`
+	Created              time.Time `json:"created"`
+	Modified             time.Time `json:"modified"`
+	Name                 string    `json:"name"`
+	LaunchType           string    `json:"launch_type"`
+	Status               string    `json:"status"`
+	Failed               bool      `json:"failed"`
+	Started              time.Time `json:"started"`
+	Finished             time.Time `json:"finished"`
+	Elapsed              float64   `json:"elapsed"`
+	JobExplanation       string    `json:"job_explanation"`
+	ExecutionNode        string    `json:"execution_node"`
+	JobType              string    `json:"job_type"`
+	Inventory            int       `json:"inventory"`
+	Limit                string    `json:"limit"`
+	Credential           int       `json:"credential"`
+	ModuleName           string    `json:"module_name"`
+	ModuleArgs           string    `json:"module_args"`
+	Forks                int       `json:"forks"`
+	Verbosity            int       `json:"verbosity"`
+	ExtraVars            string    `json:"extra_vars"`
+	BecomeEnabled        bool      `json:"become_enabled"`
+	DiffMode             bool      `json:"diff_mode"`
+	ExecutionEnvironment int       `json:"execution_environment"`
+}
+
+// WorkflowJob represents the awx api workflow job, a run of a workflow job template.
+type WorkflowJob struct {
+	ID                  int       `json:"id"`
+	Type                string    `json:"type"`
+	URL                 string    `json:"url"`
+	Created             time.Time `json:"created"`
+	Modified            time.Time `json:"modified"`
+	Name                string    `json:"name"`
+	Description         string    `json:"description"`
+	UnifiedJobTemplate  int       `json:"unified_job_template"`
+	WorkflowJobTemplate int       `json:"workflow_job_template"`
+	LaunchType          string    `json:"launch_type"`
+	Status              string    `json:"status"`
+	Failed              bool      `json:"failed"`
+	Started             time.Time `json:"started"`
+	Finished            time.Time `json:"finished"`
+	Elapsed             float64   `json:"elapsed"`
+	JobExplanation      string    `json:"job_explanation"`
+	ExtraVars           string    `json:"extra_vars"`
+	AllowSimultaneous   bool      `json:"allow_simultaneous"`
+	Inventory           int       `json:"inventory"`
+	Limit               string    `json:"limit"`
+	ScmBranch           string    `json:"scm_branch"`
+	JobTags             string    `json:"job_tags"`
+	SkipTags            string    `json:"skip_tags"`
+}
+
+// WorkflowJobNode represents the awx api workflow job node, the run of a workflow job template
+// node with the job it spawned.
+type WorkflowJobNode struct {
+	ID                     int                     `json:"id"`
+	Type                   string                  `json:"type"`
+	URL                    string                  `json:"url"`
+	SummaryFields          *WorkflowJobNodeSummary `json:"summary_fields"`
+	Created                time.Time               `json:"created"`
+	Modified               time.Time               `json:"modified"`
+	Job                    int                     `json:"job"`
+	WorkflowJob            int                     `json:"workflow_job"`
+	UnifiedJobTemplate     int                     `json:"unified_job_template"`
+	SuccessNodes           []int                   `json:"success_nodes"`
+	FailureNodes           []int                   `json:"failure_nodes"`
+	AlwaysNodes            []int                   `json:"always_nodes"`
+	AllParentsMustConverge bool                    `json:"all_parents_must_converge"`
+	DoNotRun               bool                    `json:"do_not_run"`
+	Identifier             string                  `json:"identifier"`
+}
+
+// WorkflowJobNodeSummary represents the awx api workflow job node summary fields.
+type WorkflowJobNodeSummary struct {
+	Job                *WorkflowJobNodeJob      `json:"job"`
+	UnifiedJobTemplate *WorkflowJobNodeTemplate `json:"unified_job_template"`
+}
+
+// WorkflowJobNodeJob represents the job spawned by a workflow job node.
+type WorkflowJobNodeJob struct {
+	ID          int     `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Status      string  `json:"status"`
+	Failed      bool    `json:"failed"`
+	Elapsed     float64 `json:"elapsed"`
+	Type        string  `json:"type"`
+}
+
+// WorkflowJobNodeTemplate represents the template run by a workflow job node.
+type WorkflowJobNodeTemplate struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	UnifiedJobType string `json:"unified_job_type"`
+}
+
 // Schedule : represents the awx api schedule.
 type Schedule struct {
 	ID                 int                    `json:"id"`
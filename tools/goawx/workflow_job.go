@@ -0,0 +1,168 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// WorkflowJobService implements awx workflow job apis.
+type WorkflowJobService struct {
+	client *Client
+}
+
+// ListWorkflowJobNodesResponse represents `ListWorkflowJobNodes` endpoint response.
+type ListWorkflowJobNodesResponse struct {
+	Pagination
+	Results []*WorkflowJobNode `json:"results"`
+}
+
+const workflowJobAPIEndpoint = "/api/v2/workflow_jobs/"
+
+// GetWorkflowJob shows the details of a workflow job.
+func (w *WorkflowJobService) GetWorkflowJob(id int, params map[string]string) (*WorkflowJob, error) {
+	result := new(WorkflowJob)
+	endpoint := fmt.Sprintf("%s%d/", workflowJobAPIEndpoint, id)
+	resp, err := w.client.Requester.GetJSON(endpoint, result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CancelWorkflowJob cancels a workflow job and the jobs it runs.
+func (w *WorkflowJobService) CancelWorkflowJob(id int, data map[string]interface{}, params map[string]string) (*CancelJobResponse, error) {
+	result := new(CancelJobResponse)
+	endpoint := fmt.Sprintf("%s%d/cancel/", workflowJobAPIEndpoint, id)
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := w.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// RelaunchWorkflowJob launches a workflow job again with the same prompts, returning the new workflow job.
+func (w *WorkflowJobService) RelaunchWorkflowJob(id int, data map[string]interface{}, params map[string]string) (*WorkflowJob, error) {
+	result := new(WorkflowJob)
+	endpoint := fmt.Sprintf("%s%d/relaunch/", workflowJobAPIEndpoint, id)
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := w.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListWorkflowJobNodes lists the nodes of a workflow job with the status of the job each spawned, from all pages.
+func (w *WorkflowJobService) ListWorkflowJobNodes(id int, params map[string]string) ([]*WorkflowJobNode, error) {
+	results := make([]*WorkflowJobNode, 0)
+	nextURL := fmt.Sprintf("%s%d/workflow_nodes/", workflowJobAPIEndpoint, id)
+	for {
+		nextURLParsed, err := url.Parse(nextURL)
+		if err != nil {
+			return nil, err
+		}
+
+		nextURLQueryParams := make(map[string]string)
+		for paramName, paramValues := range nextURLParsed.Query() {
+			if len(paramValues) > 0 {
+				nextURLQueryParams[paramName] = paramValues[0]
+			}
+		}
+
+		for paramName, paramValue := range params {
+			nextURLQueryParams[paramName] = paramValue
+		}
+
+		result := new(ListWorkflowJobNodesResponse)
+		resp, err := w.client.Requester.GetJSON(nextURLParsed.Path, result, nextURLQueryParams)
+		if resp != nil {
+			func() {
+				if err := resp.Body.Close(); err != nil {
+					fmt.Println(err)
+				}
+			}()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := CheckResponse(resp); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result.Results...)
+
+		if next, _ := result.Next.(string); next == "" {
+			return results, nil
+		}
+		nextURL = result.Next.(string)
+	}
+}
+
+// WorkflowJobOutputURL returns the output page of a workflow job in the AWX user interface.
+func (w *WorkflowJobService) WorkflowJobOutputURL(id int) string {
+	return outputURL(w.client, "workflow", id)
+}
+
+// WaitForWorkflowJob polls a workflow job until it reaches a terminal status. It returns the
+// workflow job when it is successful, and a *JobWaitError with the outcome otherwise.
+func (w *WorkflowJobService) WaitForWorkflowJob(ctx context.Context, id int, opts *JobWaitOptions) (*WorkflowJob, error) {
+	var job *WorkflowJob
+	err := waitForUnifiedJob(ctx, id, w.WorkflowJobOutputURL(id), opts, func() (string, string, error) {
+		got, err := w.GetWorkflowJob(id, map[string]string{})
+		if err != nil {
+			return "", "", err
+		}
+		job = got
+		return job.Status, job.JobExplanation, nil
+	})
+	return job, err
+}
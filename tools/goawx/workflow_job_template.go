@@ -145,6 +145,29 @@ func (jt *WorkflowJobTemplateService) DeleteWorkflowJobTemplate(id int) (*Workfl
 	return result, nil
 }
 
+// GetLaunchOptions shows what the workflow job template accepts when it is launched.
+func (jt *WorkflowJobTemplateService) GetLaunchOptions(id int, params map[string]string) (*JobTemplateLaunchOptions, error) {
+	result := new(JobTemplateLaunchOptions)
+	endpoint := fmt.Sprintf("%s%d/launch/", workflowJobTemplateAPIEndpoint, id)
+	resp, err := jt.client.Requester.GetJSON(endpoint, result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // Launch a job with the workflow job template.
 func (jt *WorkflowJobTemplateService) Launch(id int, data map[string]interface{}, params map[string]string) (*JobLaunch, error) {
 	result := new(JobLaunch)
@@ -130,3 +130,26 @@ func (s *WorkflowJobTemplateNotificationTemplatesService) DisassociateWorkflowJo
 func (s *WorkflowJobTemplateNotificationTemplatesService) DisassociateWorkflowJobTemplateNotificationTemplatesApprovals(jobTemplateID int, notificationTemplateID int) (*NotificationTemplate, error) {
 	return s.disassociateWorkflowJobTemplateNotificationTemplatesForType(jobTemplateID, notificationTemplateID, "approvals")
 }
+
+// ListWorkflowJobTemplateNotificationTemplates lists the notification_templates of the given type (started, success, error or approvals) associated to a workflow_job_template.
+func (s *WorkflowJobTemplateNotificationTemplatesService) ListWorkflowJobTemplateNotificationTemplates(jobTemplateID int, typ string, params map[string]string) ([]*NotificationTemplate, *ListNotificationTemplatesResponse, error) {
+	result := new(ListNotificationTemplatesResponse)
+	endpoint := fmt.Sprintf(workflowJobTemplateNotificationTemplatesAPIEndpoint, jobTemplateID, typ)
+	resp, err := s.client.Requester.GetJSON(endpoint, result, params)
+	if resp != nil {
+		func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, result, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, result, err
+	}
+
+	return result.Results, result, nil
+}
@@ -0,0 +1,74 @@
+package awx_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	awx "github.com/josh-silvas/terraform-provider-awx/tools/goawx"
+)
+
+// newWorkflowJobAWX serves workflow job 30, failed on its second node, and its relaunch as 31.
+func newWorkflowJobAWX(t *testing.T) *awx.AWX {
+	t.Helper()
+	return newRouteAWX(t, routes{
+		"GET /api/v2/workflow_jobs/30/": reply(http.StatusOK,
+			`{"id": 30, "status": "failed", "failed": true, "workflow_job_template": 12, "job_explanation": ""}`),
+		"GET /api/v2/workflow_jobs/30/workflow_nodes/": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("page") == "2" {
+				fmt.Fprint(w, `{"count": 2, "next": null, "results": [{"id": 2, "identifier": "deploy", "job": 41,
+					"summary_fields": {"job": {"id": 41, "status": "failed", "failed": true, "type": "job"},
+					"unified_job_template": {"id": 7, "name": "Deploy", "unified_job_type": "job"}}}]}`)
+				return
+			}
+			fmt.Fprint(w, `{"count": 2, "next": "/api/v2/workflow_jobs/30/workflow_nodes/?page=2", "results": [
+				{"id": 1, "identifier": "sync", "job": 40, "success_nodes": [2],
+				 "summary_fields": {"job": {"id": 40, "status": "successful", "type": "project_update"},
+				 "unified_job_template": {"id": 3, "name": "Sync", "unified_job_type": "project_update"}}}]}`)
+		},
+		"POST /api/v2/workflow_jobs/30/cancel/":   reply(http.StatusAccepted, ""),
+		"POST /api/v2/workflow_jobs/30/relaunch/": reply(http.StatusCreated, `{"id": 31, "status": "pending", "workflow_job_template": 12}`),
+	})
+}
+
+func TestWorkflowJobService(t *testing.T) {
+	client := newWorkflowJobAWX(t)
+
+	job, err := client.WorkflowJobService.GetWorkflowJob(30, map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Status != awx.JobStatusFailed || job.WorkflowJobTemplate != 12 {
+		t.Fatalf("Unexpected workflow job %+v", job)
+	}
+
+	nodes, err := client.WorkflowJobService.ListWorkflowJobNodes(30, map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 2 || nodes[1].Identifier != "deploy" || nodes[1].SummaryFields.Job.Status != awx.JobStatusFailed ||
+		nodes[1].SummaryFields.UnifiedJobTemplate.UnifiedJobType != "job" || nodes[0].SuccessNodes[0] != 2 {
+		t.Fatalf("Unexpected workflow job nodes %+v", nodes)
+	}
+
+	if _, err := client.WorkflowJobService.CancelWorkflowJob(30, map[string]interface{}{}, map[string]string{}); err != nil {
+		t.Fatal(err)
+	}
+	relaunched, err := client.WorkflowJobService.RelaunchWorkflowJob(30, map[string]interface{}{}, map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if relaunched.ID != 31 {
+		t.Fatalf("Expecting the relaunched workflow job 31 but got %+v", relaunched)
+	}
+
+	_, err = client.WorkflowJobService.WaitForWorkflowJob(context.Background(), 30, &awx.JobWaitOptions{PollInterval: time.Millisecond})
+	var waitErr *awx.JobWaitError
+	if !errors.As(err, &waitErr) || waitErr.Outcome != awx.JobOutcomeFailed || !strings.HasSuffix(waitErr.URL, "/#/jobs/workflow/30/output") {
+		t.Fatalf("Expecting a failed outcome but got %v", err)
+	}
+}
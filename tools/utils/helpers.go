@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -95,6 +96,28 @@ func StateIDToInt(tfElement string, d *schema.ResourceData) (int, diag.Diagnosti
 	return id, diags
 }
 
+// CompositeID : Build the `<parent_id>:<child_id>` ID used by association resources
+func CompositeID(parentID, childID int) string {
+	return fmt.Sprintf("%d:%d", parentID, childID)
+}
+
+// ParseCompositeID : Split a `<parent_id>:<child_id>` ID into its numeric parts
+func ParseCompositeID(id string) (int, int, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("unexpected format of ID (%s), expected <parent_id>:<child_id>", id)
+	}
+	parentID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("parent ID %q in %s is not numeric, %w", parts[0], id, err)
+	}
+	childID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("child ID %q in %s is not numeric, %w", parts[1], id, err)
+	}
+	return parentID, childID, nil
+}
+
 // Diagf : Return the message for the diag method
 func Diagf(diagSummary, diagDetails string, detailsVars ...interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -163,7 +186,7 @@ func UnmarshalYAML(str string) map[string]interface{} {
 func MarshalYAML(v interface{}) string {
 	extraDataBytes, err := yaml.Marshal(v)
 	if err != nil {
-		return string(extraDataBytes)
+		return ""
 	}
-	return ""
+	return string(extraDataBytes)
 }
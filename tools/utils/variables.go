@@ -0,0 +1,206 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v2"
+)
+
+// VariablesSchema : Return the schema of an optional YAML or JSON variables attribute. Values
+// which parse to the same data, whatever their format, key order or whitespace, cause no diff.
+func VariablesSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "",
+		Description:      description,
+		ValidateFunc:     ValidateVariables,
+		DiffSuppressFunc: SuppressEquivalentVariables,
+	}
+}
+
+// ValidateVariables : Validate that the value is a YAML or JSON document
+func ValidateVariables(v interface{}, k string) ([]string, []error) {
+	if _, err := ParseVariables(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s must be valid YAML or JSON, got %w", k, err)}
+	}
+	return nil, nil
+}
+
+// SuppressEquivalentVariables : Ignore the differences between two variables documents holding the same data
+func SuppressEquivalentVariables(_, o, n string, _ *schema.ResourceData) bool {
+	return VariablesEqual(o, n)
+}
+
+// VariablesEqual : Report whether two YAML or JSON documents hold the same data
+func VariablesEqual(a, b string) bool {
+	va, err := ParseVariables(a)
+	if err != nil {
+		return false
+	}
+	vb, err := ParseVariables(b)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// ParseVariables : Parse a YAML or JSON document into maps, slices and scalars which can be
+// compared. Empty documents, such as the `---` returned by AWX, parse to nil like an empty mapping.
+func ParseVariables(s string) (interface{}, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	// JSON documents are YAML documents, only JSON indented with tabs needs its own parser.
+	var v interface{}
+	if err := yaml.Unmarshal([]byte(s), &v); err != nil {
+		if jsonErr := json.Unmarshal([]byte(s), &v); jsonErr != nil {
+			return nil, err
+		}
+	}
+	v = normalizeVariables(v)
+	if m, ok := v.(map[string]interface{}); ok && len(m) == 0 {
+		return nil, nil
+	}
+	return v, nil
+}
+
+func normalizeVariables(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, e := range t {
+			m[fmt.Sprint(k)] = normalizeVariables(e)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(t))
+		for i, e := range t {
+			l[i] = normalizeVariables(e)
+		}
+		return l
+	case int:
+		return float64(t)
+	case int64:
+		return float64(t)
+	case uint64:
+		return float64(t)
+	}
+	return v
+}
+
+// VariablesMapSchema : Return the schema of the map form of a variables attribute, exclusive with
+// its string form. Values holding JSON, such as numbers, booleans or `jsonencode` results, are
+// sent to AWX decoded so that they keep their type.
+func VariablesMapSchema(description, conflictsWith string) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeMap,
+		Optional:         true,
+		Elem:             &schema.Schema{Type: schema.TypeString},
+		Description:      description,
+		ConflictsWith:    []string{conflictsWith},
+		DiffSuppressFunc: SuppressEquivalentVariableValue,
+	}
+}
+
+// SuppressEquivalentVariableValue : Ignore the differences between two map values holding the same JSON data
+func SuppressEquivalentVariableValue(_, o, n string, _ *schema.ResourceData) bool {
+	return reflect.DeepEqual(variableValue(o), variableValue(n))
+}
+
+// VariablesFromMap : Convert the map form of variables into the data stored by AWX
+func VariablesFromMap(m map[string]interface{}) map[string]interface{} {
+	data := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		data[k] = variableValue(v.(string))
+	}
+	return data
+}
+
+// VariablesToMap : Convert the data stored by AWX into the map form of variables, encoding
+// everything but strings as JSON
+func VariablesToMap(data map[string]interface{}) (map[string]interface{}, error) {
+	m := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		if s, ok := v.(string); ok {
+			m[k] = s
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		m[k] = string(b)
+	}
+	return m, nil
+}
+
+// Variables : Return the variables document to send to AWX, built from the map form when it is set
+func Variables(d *schema.ResourceData, key, mapKey string) string {
+	m, ok := d.GetOk(mapKey)
+	if !ok {
+		return d.Get(key).(string)
+	}
+	b, _ := json.Marshal(VariablesFromMap(m.(map[string]interface{})))
+	return string(b)
+}
+
+// VariablesData : Return the variables to send to AWX as data, for the endpoints which do not take a document
+func VariablesData(d *schema.ResourceData, key, mapKey string) map[string]interface{} {
+	if m, ok := d.GetOk(mapKey); ok {
+		return VariablesFromMap(m.(map[string]interface{}))
+	}
+	return UnmarshalYAML(d.Get(key).(string))
+}
+
+// SetVariables : Store the variables document returned by AWX in the form used by the resource
+func SetVariables(d *schema.ResourceData, key, mapKey, value string) error {
+	if _, ok := d.GetOk(mapKey); !ok {
+		if err := d.Set(mapKey, nil); err != nil {
+			return err
+		}
+		return d.Set(key, value)
+	}
+
+	parsed, err := ParseVariables(value)
+	if err != nil {
+		return err
+	}
+	data, ok := parsed.(map[string]interface{})
+	if parsed != nil && !ok {
+		return fmt.Errorf("%s is not a mapping, it can not be stored in %s", key, mapKey)
+	}
+	return SetVariablesData(d, key, mapKey, data)
+}
+
+// SetVariablesData : Store the variables data returned by AWX in the form used by the resource
+func SetVariablesData(d *schema.ResourceData, key, mapKey string, data map[string]interface{}) error {
+	if _, ok := d.GetOk(mapKey); !ok {
+		if err := d.Set(mapKey, nil); err != nil {
+			return err
+		}
+		return d.Set(key, MarshalYAML(data))
+	}
+
+	m, err := VariablesToMap(data)
+	if err != nil {
+		return err
+	}
+	if err := d.Set(key, ""); err != nil {
+		return err
+	}
+	return d.Set(mapKey, m)
+}
+
+// variableValue decodes s when it holds JSON and returns it unchanged otherwise.
+func variableValue(s string) interface{} {
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return s
+	}
+	return v
+}
@@ -0,0 +1,138 @@
+package utils_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/josh-silvas/terraform-provider-awx/tools/utils"
+)
+
+func TestVariablesEqual(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{"identical", `{"a": 1}`, `{"a": 1}`, true},
+		{"json whitespace", `{"a":1,"b":[1,2]}`, "{\n  \"a\": 1,\n  \"b\": [1, 2]\n}", true},
+		{"json tabs", "{\n\t\"a\": 1\n}", `{"a": 1}`, true},
+		{"key order", `{"a": 1, "b": 2}`, `{"b": 2, "a": 1}`, true},
+		{"yaml and json", "---\na: 1\nb:\n  - one\n  - two\n", `{"a": 1, "b": ["one", "two"]}`, true},
+		{"yaml key order", "a: 1\nb: 2\n", "b: 2\na: 1\n", true},
+		{"nested", "a:\n  b:\n    c: true\n", `{"a": {"b": {"c": true}}}`, true},
+		{"int and float", `{"a": 1}`, `{"a": 1.0}`, true},
+		{"empty and awx empty", "", "---", true},
+		{"empty and empty mapping", "", "{}", true},
+		{"whitespace only", "  \n", "", true},
+		{"different value", `{"a": 1}`, `{"a": 2}`, false},
+		{"different type", `{"a": 1}`, `{"a": "1"}`, false},
+		{"list order", `{"a": [1, 2]}`, `{"a": [2, 1]}`, false},
+		{"extra key", `{"a": 1}`, "a: 1\nb: 2\n", false},
+		{"empty and value", "", "a: 1", false},
+		{"invalid", `{"a": 1`, `{"a": 1`, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := utils.VariablesEqual(tc.a, tc.b); got != tc.want {
+				t.Errorf("VariablesEqual(%q, %q) = %v, want %v", tc.a, tc.b, got, tc.want)
+			}
+			if got := utils.SuppressEquivalentVariables("variables", tc.b, tc.a, nil); got != tc.want {
+				t.Errorf("SuppressEquivalentVariables(%q, %q) = %v, want %v", tc.b, tc.a, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidateVariables(t *testing.T) {
+	cases := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"empty", "", false},
+		{"json", `{"a": [1, 2]}`, false},
+		{"yaml", "a:\n  - 1\n  - 2\n", false},
+		{"unterminated json", `{"a": 1`, true},
+		{"bad indentation", "a: 1\n  b: 2\n", true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, errs := utils.ValidateVariables(tc.value, "variables")
+			if got := len(errs) > 0; got != tc.wantErr {
+				t.Errorf("ValidateVariables(%q) errors = %v, want error %v", tc.value, errs, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestMarshalYAML(t *testing.T) {
+	cases := []struct {
+		name string
+		in   interface{}
+		want string
+	}{
+		{"mapping", map[string]interface{}{"a": 1}, "a: 1\n"},
+		{"empty mapping", map[string]interface{}{}, "{}\n"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := utils.MarshalYAML(tc.in); got != tc.want {
+				t.Errorf("MarshalYAML(%v) = %q, want %q", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestVariablesMapRoundTrip(t *testing.T) {
+	cases := []struct {
+		name  string
+		value string
+		data  interface{}
+	}{
+		{"string", "web", "web"},
+		{"number", "8080", float64(8080)},
+		{"float", "1.5", 1.5},
+		{"bool", "true", true},
+		{"list", `["a","b"]`, []interface{}{"a", "b"}},
+		{"object", `{"a":{"b":1}}`, map[string]interface{}{"a": map[string]interface{}{"b": float64(1)}}},
+		{"not json", "{web", "{web"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := utils.VariablesFromMap(map[string]interface{}{"key": tc.value})
+			if !reflect.DeepEqual(data["key"], tc.data) {
+				t.Fatalf("VariablesFromMap(%q) = %#v, want %#v", tc.value, data["key"], tc.data)
+			}
+			m, err := utils.VariablesToMap(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if m["key"] != tc.value {
+				t.Errorf("VariablesToMap(%#v) = %q, want %q", data["key"], m["key"], tc.value)
+			}
+		})
+	}
+}
+
+func TestSuppressEquivalentVariableValue(t *testing.T) {
+	cases := []struct {
+		name string
+		o, n string
+		want bool
+	}{
+		{"same string", "web", "web", true},
+		{"float and int", "1.0", "1", true},
+		{"object whitespace", `{"a": [1, 2]}`, `{"a":[1,2]}`, true},
+		{"object key order", `{"a":1,"b":2}`, `{"b":2,"a":1}`, true},
+		{"different string", "web", "db", false},
+		{"number and string", "1", `"1"`, false},
+		{"added", "", "web", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := utils.SuppressEquivalentVariableValue("variables_map.key", tc.o, tc.n, nil); got != tc.want {
+				t.Errorf("SuppressEquivalentVariableValue(%q, %q) = %v, want %v", tc.o, tc.n, got, tc.want)
+			}
+		})
+	}
+}